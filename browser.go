@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"net/url"
+
+	"github.com/leporel/keys-generator/keys"
+)
+
+// webFiles are the templates and static assets of the page browser, embedded so that it runs offline
+//
+//go:embed web/templates web/static
+var webFiles embed.FS
+
+var browserTemplates = template.Must(template.ParseFS(webFiles, "web/templates/*.html"))
+
+// browserPage is the data that the HTML templates are executed with
+type browserPage struct {
+	Coins    []keys.Coin // the header links to the pages of every coin
+	Chain    string      // the name of the coin, its routes start with it
+	Title    string
+	Settings map[string]string // query parameters other than the defaults, the forms keep them
+	Links    links
+	Page     *pageView // nil on an error page
+	Key      string    // the searched key, kept in the search box on an error page
+	Error    string
+}
+
+// browserFormat renders the pages of a chain as HTML
+type browserFormat struct {
+	chain string
+	name  string
+}
+
+func (browserFormat) contentType() string {
+	return "text/html; charset=utf-8"
+}
+
+func (f browserFormat) renderPage(keyspace *keys.Keyspace, page pageResponse) ([]byte, error) {
+	view := newPageView(keyspace, page.Keys)
+
+	return renderBrowserPage(browserPage{
+		Coins:    keys.Coins(),
+		Chain:    f.chain,
+		Title:    fmt.Sprintf("%s keys, page %s", f.name, page.Page),
+		Settings: settings(pageParams(page.KeysPerPage, page.Network)),
+		Links:    page.Links,
+		Page:     &view,
+	})
+}
+
+// writeError renders an error page that keeps the parameters of the request in its forms
+func (f browserFormat) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	query := r.URL.Query()
+	query.Del("key")
+	query.Del("page")
+
+	body, renderErr := renderBrowserPage(browserPage{
+		Coins:    keys.Coins(),
+		Chain:    f.chain,
+		Title:    fmt.Sprintf("%s keys", f.name),
+		Settings: settings(query),
+		Key:      r.URL.Query().Get("key"),
+		Error:    err.Error(),
+	})
+	if renderErr != nil {
+		http.Error(w, renderErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeBody(w, f.contentType(), httpStatus(err), body)
+}
+
+func renderBrowserPage(page browserPage) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := browserTemplates.ExecuteTemplate(&buf, "page.html", page); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// settings returns the first value of every parameter
+func settings(query url.Values) map[string]string {
+	values := make(map[string]string, len(query))
+	for name, value := range query {
+		if len(value) > 0 {
+			values[name] = value[0]
+		}
+	}
+
+	return values
+}
+
+// browserSearch redirects to the row of a key on its page, or shows the error next to the search box
+func browserSearch(coin keys.Coin, prefix string, format browserFormat) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response, err := search(r, coin, prefix)
+		if err != nil {
+			format.writeError(w, r, err)
+			return
+		}
+
+		http.Redirect(w, r, fmt.Sprintf("%s#key-%d", response.Links.Page, response.Index), http.StatusSeeOther)
+	}
+}
+
+// handleBrowser registers the routes of the page browser
+func (s *server) handleBrowser() {
+	for _, coin := range keys.Coins() {
+		prefix := "/" + coin.Name() + "/"
+		format := browserFormat{chain: coin.Name(), name: coin.Title()}
+
+		s.mux.HandleFunc(prefix, s.coinPage(coin, prefix, format))
+		s.mux.HandleFunc("/"+coin.Name()+"-search", browserSearch(coin, prefix, format))
+	}
+
+	static, _ := fs.Sub(webFiles, "web")
+	s.mux.Handle("/static/", http.FileServer(http.FS(static)))
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		http.Redirect(w, r, "/"+keys.Coins()[0].Name()+"/1", http.StatusSeeOther)
+	})
+}
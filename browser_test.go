@@ -0,0 +1,120 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_browser(t *testing.T) {
+	tests := []struct {
+		name         string
+		target       string
+		wantStatus   int
+		wantLocation string
+		wantBody     []string
+	}{
+		{
+			"It renders a Bitcoin page",
+			"/btc/1?keys-per-page=2",
+			http.StatusOK,
+			"",
+			[]string{
+				"<title>Bitcoin keys, page 1</title>",
+				`<tr id="key-0"><td><a href="#key-0">0</a></td><td>1</td><td>5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf</td>`,
+				`<a href="/btc/2?keys-per-page=2" rel="next">Next</a>`,
+				`<span class="disabled">Previous</span>`,
+				`<input type="hidden" name="keys-per-page" value="2">`,
+			},
+		},
+		{
+			"It renders the last Ethereum page",
+			"/eth/904625697166532776746648320380374280100293470930272690489102837043110636675",
+			http.StatusOK,
+			"",
+			[]string{
+				"<h1>Page 904625697166532776746648320380374280100293470930272690489102837043110636675 of 904625697166532776746648320380374280100293470930272690489102837043110636675</h1>",
+				`<span class="disabled">Next</span>`,
+				`<form action="/eth-search" method="get">`,
+				`<a href="/all/">Bitcoin and Ethereum</a>`,
+			},
+		},
+		{
+			"It redirects the jump-to-page form",
+			"/btc/?page=42&keys-per-page=10&network=testnet",
+			http.StatusSeeOther,
+			"/btc/42?keys-per-page=10&network=testnet",
+			nil,
+		},
+		{
+			"It redirects a search to the row of the key",
+			"/btc-search?key=5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreRBLscCA&keys-per-page=100",
+			http.StatusSeeOther,
+			"/btc/2?keys-per-page=100#key-28",
+			nil,
+		},
+		{
+			"It shows a search error and keeps the key",
+			"/eth-search?key=%3Cscript%3E&keys-per-page=3",
+			http.StatusBadRequest,
+			"",
+			[]string{
+				`<p class="error">malformed private key: &#34;&lt;script&gt;&#34; is not a hex, decimal, WIF or mini private key</p>`,
+				`<input name="key" value="&lt;script&gt;"`,
+				`<input type="hidden" name="keys-per-page" value="3">`,
+			},
+		},
+		{
+			"It shows a page out of range",
+			"/eth/904625697166532776746648320380374280100293470930272690489102837043110636676",
+			http.StatusNotFound,
+			"",
+			[]string{`<p class="error">page out of range`},
+		},
+		{
+			"It serves the embedded style sheet",
+			"/static/style.css",
+			http.StatusOK,
+			"",
+			[]string{"tr:target"},
+		},
+		{
+			"It redirects the root to the first Bitcoin page",
+			"/",
+			http.StatusSeeOther,
+			"/btc/1",
+			nil,
+		},
+		{
+			"It does not serve unknown paths",
+			"/xmr/1",
+			http.StatusNotFound,
+			"",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			newServer(10).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, recorder.Code)
+			}
+
+			if location := recorder.Header().Get("Location"); location != tt.wantLocation {
+				t.Errorf("Expected: %v", tt.wantLocation)
+				t.Errorf("Actual:   %v", location)
+			}
+
+			for _, want := range tt.wantBody {
+				if !strings.Contains(recorder.Body.String(), want) {
+					t.Errorf("Expected body to contain: %v", want)
+					t.Errorf("Actual:                   %v", recorder.Body.String())
+				}
+			}
+		})
+	}
+}
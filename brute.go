@@ -1,11 +1,9 @@
 package main
 
 import (
-	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"go.uber.org/ratelimit"
-	"math/big"
 	"net/http"
 	"time"
 )
@@ -142,14 +140,6 @@ func NewChecker(rateLimit int, ethApiKey []string) Checker {
 	}
 }
 
-func getRand(Range *big.Int) *big.Int {
-	n, err := rand.Int(rand.Reader, Range)
-	if err != nil {
-		panic(err)
-	}
-	return n
-}
-
 func (c *Chkr) checkBtcBalanceWallet(compressed []string) (map[string]BTCData, bool, error) {
 	if len(compressed) > 128 {
 		return nil, false, fmt.Errorf("maxsimum adress list 128")
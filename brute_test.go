@@ -1,15 +1,38 @@
 package main
 
 import (
-	"fmt"
+	"math/big"
 	"testing"
+
+	"github.com/leporel/keys-generator/keys"
 )
 
-func Test_getRand(t *testing.T) {
-	// 904625697166532776746648320380374280100293470930272690489102837043110636675
-	max := makeBigInt("904625697166532776746648320380374280100293470930272690489102837043110636675")
+func Test_sequentialPage(t *testing.T) {
+	keyspace, err := keys.EthereumKeyspace(20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lastPage := keyspace.LastPage()
 
-	for i := 0; i < 10; i++ {
-		fmt.Println(getRand(max))
+	tests := []struct {
+		name      string
+		startPage *big.Int
+		pages     int64
+		want      *big.Int
+	}{
+		{"It starts at the start page", big.NewInt(5), 0, big.NewInt(5)},
+		{"It moves to the next pages", big.NewInt(5), 3, big.NewInt(8)},
+		{"It starts on the last page", lastPage, 0, lastPage},
+		{"It wraps around after the last page", lastPage, 1, big.NewInt(1)},
+		{"It keeps going after wrapping around", new(big.Int).Sub(lastPage, big.NewInt(1)), 4, big.NewInt(3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sequentialPage(keyspace, tt.startPage, tt.pages); got.Cmp(tt.want) != 0 {
+				t.Errorf("Expected: %v", tt.want)
+				t.Errorf("Actual:   %v", got)
+			}
+		})
 	}
 }
@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func Test_pageCache(t *testing.T) {
+	cache := newPageCache(2)
+
+	cache.add("a", []byte("1"))
+	cache.add("b", []byte("2"))
+	cache.get("a")
+	cache.add("c", []byte("3"))
+
+	if _, ok := cache.get("b"); ok {
+		t.Errorf("Expected the least recently used entry to be evicted")
+	}
+
+	for key, want := range map[string]string{"a": "1", "c": "3"} {
+		if got, ok := cache.get(key); !ok || string(got) != want {
+			t.Errorf("Expected: %v", want)
+			t.Errorf("Actual:   %s", got)
+		}
+	}
+
+	cache.add("a", []byte("4"))
+
+	if got, _ := cache.get("a"); string(got) != "4" {
+		t.Errorf("Expected an entry to be replaced, got %s", got)
+	}
+
+	if cache.len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", cache.len())
+	}
+}
+
+func Test_pageCache_disabled(t *testing.T) {
+	cache := newPageCache(0)
+
+	cache.add("a", []byte("1"))
+
+	if _, ok := cache.get("a"); ok {
+		t.Errorf("Expected a cache of size 0 to keep nothing")
+	}
+}
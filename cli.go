@@ -0,0 +1,647 @@
+package main
+
+import (
+	cryptorand "crypto/rand"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	mathrand "math/rand"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/leporel/keys-generator/keys"
+)
+
+// command is a subcommand of the CLI
+type command struct {
+	name    string
+	args    string
+	summary string
+	minArgs int
+	maxArgs int
+	// setup registers the flags of the command and returns the function that runs it
+	setup func(flags *flag.FlagSet) func(args []string, stdout io.Writer) error
+}
+
+// usageError is returned for invalid arguments, the usage of the command is printed with it
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e usageError) Unwrap() error {
+	return e.err
+}
+
+// commands are the page and search commands of every registered coin, followed by the others
+var commands = append(coinCommands(), []*command{
+	{
+		name:    "first",
+		args:    "",
+		summary: "print the number of the first page",
+		minArgs: 0,
+		maxArgs: 0,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			chain := chainFlag(flags)
+			keysPerPage := keysPerPageFlag(flags)
+
+			return func(args []string, stdout io.Writer) error {
+				keyspace, err := chainKeyspace(*chain, *keysPerPage)
+				if err != nil {
+					return err
+				}
+
+				return printPageNumber(stdout, keyspace.FirstPage())
+			}
+		},
+	},
+	{
+		name:    "last",
+		args:    "",
+		summary: "print the number of the last page",
+		minArgs: 0,
+		maxArgs: 0,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			chain := chainFlag(flags)
+			keysPerPage := keysPerPageFlag(flags)
+
+			return func(args []string, stdout io.Writer) error {
+				keyspace, err := chainKeyspace(*chain, *keysPerPage)
+				if err != nil {
+					return err
+				}
+
+				return printPageNumber(stdout, keyspace.LastPage())
+			}
+		},
+	},
+	{
+		name:    "random",
+		args:    "",
+		summary: "print the number of a random page",
+		minArgs: 0,
+		maxArgs: 0,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			chain := chainFlag(flags)
+			keysPerPage := keysPerPageFlag(flags)
+			seed := flags.Int64("seed", 0, "seed for a reproducible page, pages are unpredictable without it")
+
+			return func(args []string, stdout io.Writer) error {
+				keyspace, err := chainKeyspace(*chain, *keysPerPage)
+				if err != nil {
+					return err
+				}
+
+				random := cryptorand.Reader
+				flags.Visit(func(f *flag.Flag) {
+					if f.Name == "seed" {
+						random = mathrand.New(mathrand.NewSource(*seed))
+					}
+				})
+
+				page, err := keyspace.RandomPage(random)
+				if err != nil {
+					return err
+				}
+
+				return printPageNumber(stdout, page)
+			}
+		},
+	},
+	{
+		name:    "next",
+		args:    "<page number> [<number of pages>]",
+		summary: "print the number of the page after a page",
+		minArgs: 1,
+		maxArgs: 2,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			return stepCommand(flags, 1)
+		},
+	},
+	{
+		name:    "prev",
+		args:    "<page number> [<number of pages>]",
+		summary: "print the number of the page before a page",
+		minArgs: 1,
+		maxArgs: 2,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			return stepCommand(flags, -1)
+		},
+	},
+	{
+		name:    "serve",
+		args:    "",
+		summary: "serve pages and search as a JSON API and as HTML pages",
+		minArgs: 0,
+		maxArgs: 0,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			addr := flags.String("addr", "localhost:8080", "address to listen on")
+			cacheSize := flags.Int("cache", 1024, "number of rendered pages to keep in memory, 0 disables the cache")
+
+			return func(args []string, stdout io.Writer) error {
+				fmt.Fprintf(stdout, "Listening on http://%s\n", *addr)
+
+				return http.ListenAndServe(*addr, newServer(*cacheSize))
+			}
+		},
+	},
+	{
+		name:    "btc-brute",
+		args:    "<number of workers>",
+		summary: "check random Bitcoin pages for balances",
+		minArgs: 1,
+		maxArgs: 1,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			output := flags.String("output", "./btc_output.txt", "file that found keys are appended to")
+
+			return func(args []string, stdout io.Writer) error {
+				workers, err := workersArg(args[0])
+				if err != nil {
+					return err
+				}
+
+				bruteKeys(workers, 50, nil, nil, *output, btcWorker)
+
+				return waitForSignal()
+			}
+		},
+	},
+	{
+		name:    "eth-brute",
+		args:    "<number of workers> [<api key>,<api key>...] [<start private key>]",
+		summary: "check Ethereum pages for balances, random pages or pages from a start key",
+		minArgs: 1,
+		maxArgs: 3,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			output := flags.String("output", "./eth_output.txt", "file that found keys are appended to")
+
+			return func(args []string, stdout io.Writer) error {
+				workers, err := workersArg(args[0])
+				if err != nil {
+					return err
+				}
+
+				apiKeys, start := apiKeysAndStart(args[1:])
+				startPage, err := bruteStartPage(start)
+				if err != nil {
+					return err
+				}
+
+				rate := 270
+				if len(apiKeys) == 0 {
+					apiKeys = []string{"YourApiKeyToken"}
+					rate = 10
+				}
+
+				bruteKeys(workers, rate, apiKeys, startPage, *output, ethWorker)
+
+				return waitForSignal()
+			}
+		},
+	},
+	{
+		name:    "bsc-brute",
+		args:    "<number of workers> <api key>,<api key>... [<start private key>]",
+		summary: "check Binance Smart Chain pages for balances, random pages or pages from a start key",
+		minArgs: 2,
+		maxArgs: 3,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			output := flags.String("output", "./bsc_output.txt", "file that found keys are appended to")
+
+			return func(args []string, stdout io.Writer) error {
+				workers, err := workersArg(args[0])
+				if err != nil {
+					return err
+				}
+
+				apiKeys, start := apiKeysAndStart(args[1:])
+				if len(apiKeys) == 0 {
+					return usageError{errors.New("api key not provided")}
+				}
+
+				startPage, err := bruteStartPage(start)
+				if err != nil {
+					return err
+				}
+
+				bruteKeys(workers, 290, apiKeys, startPage, *output, bscWorker)
+
+				return waitForSignal()
+			}
+		},
+	},
+}...)
+
+// coinCommands returns the page and the search command of every registered coin
+func coinCommands() []*command {
+	var coinCommands []*command
+	for _, coin := range keys.Coins() {
+		coinCommands = append(coinCommands, pageCommand(coin), searchCommand(coin))
+	}
+
+	return coinCommands
+}
+
+func pageCommand(coin keys.Coin) *command {
+	return &command{
+		name:    coin.Name(),
+		args:    "<page number>",
+		summary: fmt.Sprintf("print a page or a range of pages of %s keys", coin.Title()),
+		minArgs: 1,
+		maxArgs: 1,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			keysPerPage := keysPerPageFlag(flags)
+			network := networkFlag(flags, coin)
+			format := formatFlag(flags)
+			templateFile := templateFlag(flags)
+
+			// a coin whose rows have the public key does not need the flag
+			publicKeys := new(bool)
+			if !hasPublicKeys(coin) {
+				publicKeys = publicKeysFlag(flags)
+			}
+
+			return func(args []string, stdout io.Writer) error {
+				coin, err := coin.Network(*network)
+				if err != nil {
+					return usageError{err}
+				}
+
+				out, err := pageOutput(*format, *templateFile)
+				if err != nil {
+					return err
+				}
+
+				out.publicKeys = *publicKeys
+
+				return printKeys(stdout, coin, args[0], *keysPerPage, out)
+			}
+		},
+	}
+}
+
+func searchCommand(coin keys.Coin) *command {
+	return &command{
+		name:    coin.Name() + "-search",
+		args:    "<private key>",
+		summary: fmt.Sprintf("print the page, row and seed of a private key on %s pages", coin.Title()),
+		minArgs: 1,
+		maxArgs: 1,
+		setup: func(flags *flag.FlagSet) func([]string, io.Writer) error {
+			keysPerPage := keysPerPageFlag(flags)
+			network := networkFlag(flags, coin)
+			verbose := flags.Bool("verbose", false, "print the format, page, row and seed of the key instead of only its page")
+			context := contextFlag(flags)
+
+			return func(args []string, stdout io.Writer) error {
+				coin, err := coin.Network(*network)
+				if err != nil {
+					return usageError{err}
+				}
+
+				if *context < 0 {
+					return usageError{fmt.Errorf("invalid number of context keys %d", *context)}
+				}
+
+				return printSearch(stdout, coin, args[0], *keysPerPage, *verbose || *context > 0, *context)
+			}
+		},
+	}
+}
+
+// hasPublicKeys reports whether the rows of a coin already have the public key columns
+func hasPublicKeys(coin keys.Coin) bool {
+	for _, column := range coin.Columns() {
+		if column == keys.PublicKeyColumns()[0] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keysPerPageFlag(flags *flag.FlagSet) *int {
+	keysPerPage := defaultKeysPerPage
+	flags.Var((*keysPerPageValue)(&keysPerPage), "keys-per-page", fmt.Sprintf("`number` of keys on a page, 1 to %d", maxKeysPerPage))
+
+	return &keysPerPage
+}
+
+// keysPerPageValue is the value of -keys-per-page, it is capped like the keys-per-page parameter
+// of the server so that a page fits in memory
+type keysPerPageValue int
+
+func (v *keysPerPageValue) String() string {
+	return strconv.Itoa(int(*v))
+}
+
+func (v *keysPerPageValue) Set(value string) error {
+	keysPerPage, err := strconv.Atoi(value)
+	if err != nil || keysPerPage < 1 || keysPerPage > maxKeysPerPage {
+		return fmt.Errorf("%w %q, expected 1 to %d", keys.ErrInvalidKeysPerPage, value, maxKeysPerPage)
+	}
+
+	*v = keysPerPageValue(keysPerPage)
+
+	return nil
+}
+
+func formatFlag(flags *flag.FlagSet) *string {
+	return flags.String("format", formatText, "output format: "+strings.Join(formats, ", "))
+}
+
+func templateFlag(flags *flag.FlagSet) *string {
+	return flags.String("template", "", "text/template file that the page is rendered with, instead of -format")
+}
+
+func publicKeysFlag(flags *flag.FlagSet) *bool {
+	return flags.Bool("public-keys", false, "also print the public key, its hash160 and its keccak digest")
+}
+
+// pageOutput checks the output flags of the page commands
+func pageOutput(format, templateFile string) (output, error) {
+	if err := checkFormat(format); err != nil {
+		return output{}, err
+	}
+
+	if templateFile == "" {
+		return output{format: format}, nil
+	}
+
+	if format != formatText {
+		return output{}, usageError{errors.New("-format and -template cannot be used together")}
+	}
+
+	tmpl, err := loadTemplate(templateFile)
+	if err != nil {
+		return output{}, err
+	}
+
+	return output{template: tmpl}, nil
+}
+
+func contextFlag(flags *flag.FlagSet) *int {
+	return flags.Int("context", 0, "also print the rows of this many keys before and after the key on its page, implies -verbose")
+}
+
+func chainFlag(flags *flag.FlagSet) *string {
+	return flags.String("chain", keys.Bitcoin.Name(), "chain of the pages: "+keys.CoinNames())
+}
+
+// chainKeyspace returns the keyspace of the pages of a chain
+func chainKeyspace(chain string, keysPerPage int) (*keys.Keyspace, error) {
+	coin, ok := keys.LookupCoin(chain)
+	if !ok {
+		return nil, usageError{fmt.Errorf("unknown chain %q, expected %s", chain, keys.CoinNames())}
+	}
+
+	return coin.Keyspace(keysPerPage)
+}
+
+// stepCommand runs next and prev, direction is 1 for next and -1 for prev
+func stepCommand(flags *flag.FlagSet, direction int64) func([]string, io.Writer) error {
+	chain := chainFlag(flags)
+	keysPerPage := keysPerPageFlag(flags)
+
+	return func(args []string, stdout io.Writer) error {
+		keyspace, err := chainKeyspace(*chain, *keysPerPage)
+		if err != nil {
+			return err
+		}
+
+		page, err := keyspace.Page(args[0])
+		if err != nil {
+			return err
+		}
+
+		steps := big.NewInt(1)
+		if len(args) > 1 {
+			var ok bool
+			if steps, ok = new(big.Int).SetString(args[1], 10); !ok || steps.Sign() < 0 {
+				return usageError{fmt.Errorf("invalid number of pages %q", args[1])}
+			}
+		}
+
+		page, err = keyspace.Step(page, steps.Mul(steps, big.NewInt(direction)))
+		if err != nil {
+			return err
+		}
+
+		return printPageNumber(stdout, page)
+	}
+}
+
+// networkFlag registers -network for a coin with networks, the network of any other coin is empty
+func networkFlag(flags *flag.FlagSet, coin keys.Coin) *string {
+	networks := coin.Networks()
+	if len(networks) == 0 {
+		return new(string)
+	}
+
+	return flags.String("network", networks[0], "network of the keys: "+strings.Join(networks, ", "))
+}
+
+func workersArg(arg string) (int, error) {
+	workers, err := strconv.Atoi(arg)
+	if err != nil || workers < 1 {
+		return 0, usageError{fmt.Errorf("invalid number of workers %q", arg)}
+	}
+
+	return workers, nil
+}
+
+func apiKeysAndStart(args []string) ([]string, string) {
+	var apiKeys []string
+	var start string
+
+	if len(args) > 0 && args[0] != "" {
+		apiKeys = strings.Split(args[0], ",")
+	}
+
+	if len(args) > 1 {
+		start = args[1]
+	}
+
+	return apiKeys, start
+}
+
+// bruteStartPage returns the Ethereum page of 20 keys that the start key of eth-brute and bsc-brute
+// is on, nil without a start key
+func bruteStartPage(start string) (*big.Int, error) {
+	if start == "" {
+		return nil, nil
+	}
+
+	location, err := keys.LocateEthPrivateKey(start, 20)
+	if err != nil {
+		return nil, err
+	}
+
+	return location.Page, nil
+}
+
+// waitForSignal blocks the brute commands until they are interrupted
+func waitForSignal() error {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	<-c
+
+	return errors.New("interrupted")
+}
+
+func findCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+
+	return nil
+}
+
+// run runs the CLI with the arguments after the program name and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		if len(args) > 1 {
+			if cmd := findCommand(args[1]); cmd != nil {
+				flags, _ := newFlagSet(cmd, stderr)
+				printCommandUsage(stdout, cmd, flags)
+
+				return 0
+			}
+
+			fmt.Fprintf(stderr, "Error: unknown command %q\n\n", args[1])
+			printUsage(stderr)
+
+			return exitUsage
+		}
+
+		printUsage(stdout)
+
+		return 0
+	}
+
+	cmd := findCommand(args[0])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "Error: unknown command %q\n\n", args[0])
+		printUsage(stderr)
+
+		return exitUsage
+	}
+
+	flags, runCommand := newFlagSet(cmd, stderr)
+
+	positional, err := parseInterspersed(flags, args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		printCommandUsage(stdout, cmd, flags)
+
+		return 0
+	}
+	if err != nil {
+		// the flag package already printed the error
+		fmt.Fprintln(stderr)
+		printCommandUsage(stderr, cmd, flags)
+
+		return exitUsage
+	}
+
+	if len(positional) < cmd.minArgs || len(positional) > cmd.maxArgs {
+		if cmd.maxArgs == 0 {
+			fmt.Fprintf(stderr, "Error: %s takes no arguments\n\n", cmd.name)
+		} else {
+			fmt.Fprintf(stderr, "Error: %s expects %s\n\n", cmd.name, cmd.args)
+		}
+		printCommandUsage(stderr, cmd, flags)
+
+		return exitUsage
+	}
+
+	if err := runCommand(positional, stdout); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+
+		var usageErr usageError
+		if errors.As(err, &usageErr) {
+			fmt.Fprintln(stderr)
+			printCommandUsage(stderr, cmd, flags)
+		}
+
+		return exitCode(err)
+	}
+
+	return 0
+}
+
+// newFlagSet returns the flag set of a command with its flags registered, the flag package
+// does not print the usage itself so that help goes to stdout and errors go to stderr
+func newFlagSet(cmd *command, output io.Writer) (*flag.FlagSet, func([]string, io.Writer) error) {
+	flags := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {}
+
+	return flags, cmd.setup(flags)
+}
+
+func printCommandUsage(w io.Writer, cmd *command, flags *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: %s\n\n%s\n", strings.TrimSpace("keys-generator "+cmd.name+" [flags] "+cmd.args), cmd.summary)
+
+	hasFlags := false
+	flags.VisitAll(func(*flag.Flag) { hasFlags = true })
+
+	if hasFlags {
+		fmt.Fprintf(w, "\nFlags:\n")
+		flags.SetOutput(w)
+		flags.PrintDefaults()
+	}
+}
+
+// parseInterspersed parses flags that are placed before, between or after the positional
+// arguments, flag.Parse stops at the first positional argument. Everything after "--" is positional.
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+
+		if flags.NArg() == 0 {
+			return positional, nil
+		}
+
+		if parsed := len(args) - flags.NArg(); parsed > 0 && args[parsed-1] == "--" {
+			return append(positional, flags.Args()...), nil
+		}
+
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: keys-generator <command> [flags] [arguments]\n\nCommands:\n")
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(tw, "  %s\t%s\n", cmd.name, cmd.summary)
+	}
+	_ = tw.Flush()
+
+	fmt.Fprintf(w, "\nRun \"keys-generator help <command>\" for the flags and arguments of a command.\n")
+}
@@ -0,0 +1,456 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func Test_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			"It prints the usage without arguments",
+			[]string{},
+			exitUsage,
+			"",
+			"Commands:",
+		},
+		{
+			"It prints the usage for help",
+			[]string{"help"},
+			0,
+			"btc-search",
+			"",
+		},
+		{
+			"It prints the usage of a command for help",
+			[]string{"help", "eth"},
+			0,
+			"-keys-per-page",
+			"",
+		},
+		{
+			"It prints the usage of a command for --help",
+			[]string{"btc", "--help"},
+			0,
+			"-network",
+			"",
+		},
+		{
+			"It rejects an unknown command",
+			[]string{"xmr", "1"},
+			exitUsage,
+			"",
+			`unknown command "xmr"`,
+		},
+		{
+			"It rejects an unknown flag",
+			[]string{"btc", "-foo", "1"},
+			exitUsage,
+			"",
+			"flag provided but not defined: -foo",
+		},
+		{
+			"It rejects a missing argument",
+			[]string{"eth-search"},
+			exitUsage,
+			"",
+			"eth-search expects <private key>",
+		},
+		{
+			"It rejects too many arguments",
+			[]string{"btc", "1", "2"},
+			exitUsage,
+			"",
+			"btc expects <page number>",
+		},
+		{
+			"It rejects an unknown network",
+			[]string{"btc", "-network", "foonet", "1"},
+			exitUsage,
+			"",
+			`unknown bitcoin network "foonet"`,
+		},
+		{
+			"It rejects an invalid page size",
+			[]string{"eth", "-keys-per-page", "0", "1"},
+			exitUsage,
+			"",
+			"invalid number of keys per page",
+		},
+		{
+			"It rejects a page size that does not fit in memory",
+			[]string{"btc", "1", "-keys-per-page", "9999999999999"},
+			exitUsage,
+			"",
+			`invalid number of keys per page "9999999999999", expected 1 to 1024`,
+		},
+		{
+			"It rejects arguments to serve",
+			[]string{"serve", "1"},
+			exitUsage,
+			"",
+			"serve takes no arguments",
+		},
+		{
+			"It rejects an invalid number of workers",
+			[]string{"btc-brute", "many"},
+			exitUsage,
+			"",
+			`invalid number of workers "many"`,
+		},
+		{
+			"It prints a Bitcoin page with flags after the page number",
+			[]string{"btc", "1", "-keys-per-page", "1", "-network", "testnet"},
+			0,
+			"mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r",
+			"",
+		},
+		{
+			"It prints an Ethereum page",
+			[]string{"eth", "-keys-per-page", "2", "1"},
+			0,
+			"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+			"",
+		},
+		{
+			"It renders a page with a template",
+			[]string{"btc", "-template", "testdata/btc-markdown.tmpl", "1"},
+			0,
+			"| 0 | 1 | KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn | bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 |",
+			"",
+		},
+		{
+			"It rejects a template together with a format",
+			[]string{"eth", "-format", "csv", "-template", "testdata/eth-page.tmpl", "1"},
+			exitUsage,
+			"",
+			"-format and -template cannot be used together",
+		},
+		{
+			"It rejects a missing template",
+			[]string{"eth", "-template", "testdata/missing.tmpl", "1"},
+			exitUsage,
+			"",
+			"template: open testdata/missing.tmpl",
+		},
+		{
+			"It finds a Bitcoin key",
+			[]string{"btc-search", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreRBLscCA"},
+			0,
+			"2",
+			"",
+		},
+		{
+			"It prints the row and seed of a key",
+			[]string{"btc-search", "-verbose", "-keys-per-page", "100", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreRBLscCA"},
+			0,
+			"page 2\nindex 28\nseed 129",
+			"",
+		},
+		{
+			"It prints the keys around a key on its page",
+			[]string{"eth-search", "-context", "1", "0000000000000000000000000000000000000000000000000000000000000000"},
+			0,
+			"seed 0\n\n{0000000000000000000000000000000000000000000000000000000000000000 } (invalid)\n{0000000000000000000000000000000000000000000000000000000000000001 0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf}",
+			"",
+		},
+		{
+			"It marks the keys after the curve order",
+			[]string{"eth", "-keys-per-page", "2", "last"},
+			0,
+			"{fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415f 0xA56160A359F2EAa66f5c9df5245542B07339A9a6} (wrapped)",
+			"",
+		},
+		{
+			"It can print the public keys",
+			[]string{"eth", "-keys-per-page", "1", "-public-keys", "2"},
+			0,
+			"{0000000000000000000000000000000000000000000000000000000000000001 0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf 0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798 0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8 751e76e8199196d454941c45d1b3a323f1433bd6 c0a6c424ac7157ae408398df7e5f4552091a69125d5dfcb7b8c2659029395bdf}",
+			"",
+		},
+		{
+			"It finds a key on the combined pages",
+			[]string{"all-search", "-verbose", "-keys-per-page", "3", "0x5"},
+			0,
+			"format hex\npage 2\nindex 1\nseed 5",
+			"",
+		},
+		{
+			"It rejects a malformed start key of eth-brute",
+			[]string{"eth-brute", "1", "", "zz"},
+			exitMalformedKey,
+			"",
+			"malformed private key",
+		},
+		{
+			"It rejects a start key of bsc-brute after the last page",
+			[]string{"bsc-brute", "1", "key", "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+			exitKeyOutOfRange,
+			"",
+			"private key outside the curve order",
+		},
+		{
+			"It prints Dogecoin keys",
+			[]string{"doge", "-keys-per-page", "1", "1"},
+			0,
+			"DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE",
+			"",
+		},
+		{
+			"It prints Litecoin testnet keys",
+			[]string{"ltc", "-network", "testnet", "-keys-per-page", "1", "1"},
+			0,
+			"tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0",
+			"",
+		},
+		{
+			"It finds a Litecoin WIF",
+			[]string{"ltc-search", "-verbose", "-keys-per-page", "3", "T33ydQRKp4FCW5LCLLUB7deioUMoveiwekdwUwyfRDeGZm76aUjV"},
+			0,
+			"format compressed WIF\npage 1\nindex 0\nseed 1",
+			"",
+		},
+		{
+			"It prints Bitcoin Cash keys",
+			[]string{"bch", "-format", "csv", "-keys-per-page", "1", "1"},
+			0,
+			"bitcoincash:qp63uahgrxged4z5jswyt5dn5v3lzsem6cy4spdc2h",
+			"",
+		},
+		{
+			"It prints Tron keys",
+			[]string{"trx", "-keys-per-page", "2", "1"},
+			0,
+			"TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC 417e5f4552091a69125d5dfcb7b8c2659029395bdf",
+			"",
+		},
+		{
+			"It finds a key on the Tron pages",
+			[]string{"trx-search", "-verbose", "-keys-per-page", "2", "0x1"},
+			0,
+			"format hex\npage 1\nindex 1\nseed 1",
+			"",
+		},
+		{
+			"It rejects a network that the coin does not have",
+			[]string{"dash", "-network", "signet", "1"},
+			exitUsage,
+			"",
+			`unknown dash network "signet", expected mainnet or testnet`,
+		},
+		{
+			"It rejects a negative number of context keys",
+			[]string{"eth-search", "-context", "-1", "01"},
+			exitUsage,
+			"",
+			"invalid number of context keys -1",
+		},
+		{
+			"It finds a Bitcoin key from a decimal seed",
+			[]string{"btc-search", "-verbose", "-keys-per-page", "100", "dec:129"},
+			0,
+			"format decimal\npage 2\nindex 28\nseed 129",
+			"",
+		},
+		{
+			"It finds a Bitcoin key from a testnet WIF",
+			[]string{"btc-search", "-verbose", "-network", "testnet", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87K7XCyj5v"},
+			0,
+			"format compressed testnet WIF\npage 1\nindex 1\nseed 2",
+			"",
+		},
+		{
+			"It rejects a WIF of another network",
+			[]string{"btc-search", "-network", "testnet", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"},
+			exitMalformedKey,
+			"",
+			"is not for testnet3",
+		},
+		{
+			"It finds an Ethereum key from a WIF",
+			[]string{"eth-search", "-verbose", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"},
+			0,
+			"format compressed WIF\npage 1\nindex 1\nseed 1",
+			"",
+		},
+		{
+			"It finds an Ethereum key from a mini private key",
+			[]string{"eth-search", "-verbose", "S6c56bnXQiBjk9mqSYE7ykVQ7NzrRy"},
+			0,
+			"format mini private key\npage 270252881582779558292813824447935528849371135879642420246807724351999250324\nindex 43",
+			"",
+		},
+		{
+			"It reads an Ethereum key of only digits as hex",
+			[]string{"eth-search", "-verbose", "-keys-per-page", "1", "10"},
+			0,
+			"format hex\npage 17",
+			"",
+		},
+		{
+			"It finds an Ethereum key from a decimal seed",
+			[]string{"eth-search", "-verbose", "-keys-per-page", "1", "dec:10"},
+			0,
+			"format decimal\npage 11",
+			"",
+		},
+		{
+			"It finds an Ethereum key",
+			[]string{"eth-search", "-keys-per-page", "1", "0000000000000000000000000000000000000000000000000000000000000010"},
+			0,
+			"17",
+			"",
+		},
+		{
+			"It prints the first page",
+			[]string{"first"},
+			0,
+			"1",
+			"",
+		},
+		{
+			"It prints the last page for the keys per page",
+			[]string{"last", "-chain", "eth", "-keys-per-page", "20"},
+			0,
+			"5789604461865809771178549250434395392641878213953745219130258157075908074719",
+			"",
+		},
+		{
+			"It prints a reproducible random page",
+			[]string{"random", "-seed", "1"},
+			0,
+			"448751739716896447867828197901968677276551267031696372020221513602628240970",
+			"",
+		},
+		{
+			"It prints the next page",
+			[]string{"next", "-chain", "eth", "41", "10"},
+			0,
+			"51",
+			"",
+		},
+		{
+			"It rejects a page before the first page",
+			[]string{"prev", "1"},
+			exitPageOutOfRange,
+			"",
+			"page 0 is before the first page",
+		},
+		{
+			"It rejects an unknown chain",
+			[]string{"last", "-chain", "xmr"},
+			exitUsage,
+			"",
+			`unknown chain "xmr"`,
+		},
+		{
+			"It prints a page of a hex page expression",
+			[]string{"eth", "-keys-per-page", "1", "0x10+1"},
+			0,
+			"0000000000000000000000000000000000000000000000000000000000000010",
+			"",
+		},
+		{
+			"It prints a range of pages",
+			[]string{"btc", "-keys-per-page", "1", "-format", "csv", "last-1..last"},
+			0,
+			"115792089237316195423570985008687907852837564279074904382605163141518161494336,0,115792089237316195423570985008687907852837564279074904382605163141518161494336",
+			"",
+		},
+		{
+			"It rejects a page expression after the last page",
+			[]string{"btc", "last+1"},
+			exitPageOutOfRange,
+			"",
+			"page last+1 is after the last page",
+		},
+		{
+			"It rejects a range that ends before it starts",
+			[]string{"eth", "10..1"},
+			exitInvalidPage,
+			"",
+			`range "10..1" ends before it starts`,
+		},
+		{
+			"It accepts arguments that look like flags after --",
+			[]string{"btc", "--", "-1"},
+			exitInvalidPage,
+			"",
+			`invalid page number "-1"`,
+		},
+		{
+			"It exits with a distinct code for pages out of range",
+			[]string{"eth", "904625697166532776746648320380374280100293470930272690489102837043110636676"},
+			exitPageOutOfRange,
+			"",
+			"page out of range",
+		},
+		{
+			"It exits with a distinct code for malformed keys",
+			[]string{"btc-search", "not-a-wif"},
+			exitMalformedKey,
+			"",
+			"malformed private key",
+		},
+		{
+			"It exits with a distinct code for keys outside the curve order",
+			[]string{"eth-search", "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+			exitKeyOutOfRange,
+			"",
+			"outside the curve order",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			if gotCode := run(tt.args, &stdout, &stderr); gotCode != tt.wantCode {
+				t.Errorf("Expected exit code %d, got %d", tt.wantCode, gotCode)
+				t.Errorf("Stderr: %v", stderr.String())
+			}
+
+			if !strings.Contains(stdout.String(), tt.wantStdout) {
+				t.Errorf("Expected stdout to contain: %v", tt.wantStdout)
+				t.Errorf("Actual:                     %v", stdout.String())
+			}
+
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("Expected stderr to contain: %v", tt.wantStderr)
+				t.Errorf("Actual:                     %v", stderr.String())
+			}
+		})
+	}
+}
+
+// Test_run_searchPage checks that search prints only the page by default, scripts and keys.lol read it
+func Test_run_searchPage(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantStdout string
+	}{
+		{"It prints the page of a Bitcoin key", []string{"btc-search", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreRBLscCA"}, "2\n"},
+		{"It prints the page of an Ethereum key", []string{"eth-search", "-keys-per-page", "1", "0000000000000000000000000000000000000000000000000000000000000010"}, "17\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			if gotCode := run(tt.args, &stdout, &stderr); gotCode != 0 {
+				t.Fatalf("Expected exit code 0, got %d: %v", gotCode, stderr.String())
+			}
+
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("Expected: %q", tt.wantStdout)
+				t.Errorf("Actual:   %q", stdout.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/template"
+
+	"github.com/leporel/keys-generator/keys"
+)
+
+// The output formats of the page commands
+const (
+	formatText   = "text"
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+	formatCSV    = "csv"
+)
+
+var formats = []string{formatText, formatJSON, formatNDJSON, formatCSV}
+
+// output is how the page commands write a page: in one of the formats, or with a user template
+type output struct {
+	format     string
+	template   *template.Template
+	publicKeys bool // append the public key columns to the fields of the chain
+}
+
+// field is a named column of a record
+type field struct {
+	name  string
+	value string
+}
+
+// record is one key of a page. The machine-readable formats start every record with
+// its page number, its index on the page, the decimal value of its seed and the status of the seed.
+type record struct {
+	page   string
+	index  int
+	seed   string
+	status keys.SeedStatus
+	fields []field
+}
+
+// MarshalJSON keeps the fields in column order, a map would sort them
+func (r record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, `{"page":%q,"index":%d,"seed":%q,"status":%q`, r.page, r.index, r.seed, r.status)
+
+	for _, f := range r.fields {
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintf(&buf, ",%q:%s", f.name, value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// namedFields names the values of a row after its columns
+func namedFields(columns, values []string) []field {
+	fields := make([]field, 0, len(columns))
+	for i, name := range columns {
+		fields = append(fields, field{name, values[i]})
+	}
+
+	return fields
+}
+
+// pageRecords numbers the rows of a page, the first row has the first seed of the page
+func pageRecords(keyspace *keys.Keyspace, pageNumber string, rows [][]field) ([]record, error) {
+	page, err := keyspace.Page(pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, _ := keyspace.PageSeeds(page)
+
+	records := make([]record, 0, len(rows))
+
+	for i, fields := range rows {
+		rowSeed := new(big.Int).Add(seed, big.NewInt(int64(i)))
+		_, status := keys.SeedScalar(rowSeed)
+
+		records = append(records, record{
+			page:   page.String(),
+			index:  i,
+			seed:   rowSeed.String(),
+			status: status,
+			fields: fields,
+		})
+	}
+
+	return records, nil
+}
+
+// writePages writes the pages of a page expression or range in order, each page is written as
+// soon as its rows are generated
+func writePages(w io.Writer, out output, keyspace *keys.Keyspace, pageRange string, rows func(pageNumber string) ([][]field, error)) error {
+	from, to, err := keyspace.PageRange(pageRange)
+	if err != nil {
+		return err
+	}
+
+	pages := &pageWriter{w: w, out: out}
+
+	for page := from; page.Cmp(to) <= 0; page = new(big.Int).Add(page, big.NewInt(1)) {
+		pageRows, err := rows(page.String())
+		if err != nil {
+			return err
+		}
+
+		if out.publicKeys {
+			if pageRows, err = withPublicKeys(keyspace, page.String(), pageRows); err != nil {
+				return err
+			}
+		}
+
+		records, err := pageRecords(keyspace, page.String(), pageRows)
+		if err != nil {
+			return err
+		}
+
+		if err := pages.write(keyspace, records); err != nil {
+			return err
+		}
+	}
+
+	return pages.close()
+}
+
+// withPublicKeys appends the public key fields of its seed to every row of a page
+func withPublicKeys(keyspace *keys.Keyspace, pageNumber string, rows [][]field) ([][]field, error) {
+	publicKeys, err := keys.GeneratePublicKeys(keyspace, pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		rows[i] = append(rows[i], namedFields(keys.PublicKeyColumns(), publicKeys[i].Values())...)
+	}
+
+	return rows, nil
+}
+
+func checkFormat(format string) error {
+	for _, f := range formats {
+		if f == format {
+			return nil
+		}
+	}
+
+	return usageError{fmt.Errorf("unknown format %q, expected %s", format, strings.Join(formats, ", "))}
+}
+
+// pageWriter writes pages one after another as a single document: one JSON array, one CSV
+// header, and the text rows of all pages separated by newlines
+type pageWriter struct {
+	w       io.Writer
+	out     output
+	pages   int
+	records int
+}
+
+func (p *pageWriter) write(keyspace *keys.Keyspace, records []record) error {
+	defer func() {
+		p.pages++
+		p.records += len(records)
+	}()
+
+	if p.out.template != nil {
+		return writeTemplate(p.w, p.out.template, newPageView(keyspace, records))
+	}
+
+	switch p.out.format {
+	case formatJSON:
+		return p.writeJSON(records)
+	case formatNDJSON:
+		encoder := json.NewEncoder(p.w)
+
+		for _, r := range records {
+			if err := encoder.Encode(r); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case formatCSV:
+		return writeCSV(p.w, records, p.pages == 0)
+	default:
+		if p.records > 0 && len(records) > 0 {
+			fmt.Fprint(p.w, "\n")
+		}
+
+		return writeText(p.w, records)
+	}
+}
+
+// writeJSON writes records as elements of the array that close ends, indented like
+// json.Encoder with SetIndent("", "  ") would indent the whole array
+func (p *pageWriter) writeJSON(records []record) error {
+	for i, r := range records {
+		element, err := json.MarshalIndent(r, "  ", "  ")
+		if err != nil {
+			return err
+		}
+
+		separator := ",\n  "
+		if p.records+i == 0 {
+			separator = "[\n  "
+		}
+
+		if _, err := fmt.Fprintf(p.w, "%s%s", separator, element); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// close ends the document
+func (p *pageWriter) close() error {
+	if p.out.template != nil || p.out.format != formatJSON {
+		return nil
+	}
+
+	if p.records == 0 {
+		_, err := fmt.Fprint(p.w, "[]\n")
+		return err
+	}
+
+	_, err := fmt.Fprint(p.w, "\n]\n")
+
+	return err
+}
+
+// writeText writes the rows the way keys.lol reads them: the values of a row between braces,
+// separated by spaces, without a newline after the last row. A row whose seed is not a valid
+// private key is followed by its status, e.g. "(wrapped)".
+func writeText(w io.Writer, records []record) error {
+	for i, r := range records {
+		values := make([]string, 0, len(r.fields))
+		for _, f := range r.fields {
+			values = append(values, f.value)
+		}
+
+		if _, err := fmt.Fprintf(w, "{%s}", strings.Join(values, " ")); err != nil {
+			return err
+		}
+
+		if r.status != keys.SeedValid {
+			fmt.Fprintf(w, " (%s)", r.status)
+		}
+
+		if i != len(records)-1 {
+			fmt.Fprint(w, "\n")
+		}
+	}
+
+	return nil
+}
+
+func writeCSV(w io.Writer, records []record, withHeader bool) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	writer := csv.NewWriter(w)
+
+	if withHeader {
+		header := []string{"page", "index", "seed", "status"}
+		for _, f := range records[0].fields {
+			header = append(header, f.name)
+		}
+
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range records {
+		row := []string{r.page, fmt.Sprintf("%d", r.index), r.seed, string(r.status)}
+		for _, f := range r.fields {
+			row = append(row, f.value)
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/leporel/keys-generator/keys"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func Test_pageWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		print  func(w *bytes.Buffer) error
+	}{
+		{"Bitcoin text", "btc.txt", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Bitcoin, "1", 2, output{format: formatText})
+		}},
+		{"Bitcoin JSON", "btc.json", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Bitcoin, "1", 2, output{format: formatJSON})
+		}},
+		{"Bitcoin NDJSON", "btc.ndjson", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Bitcoin, "1", 2, output{format: formatNDJSON})
+		}},
+		{"Bitcoin CSV", "btc.csv", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Bitcoin, "1", 2, output{format: formatCSV})
+		}},
+		{"Ethereum text", "eth.txt", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Ethereum, "2", 2, output{format: formatText})
+		}},
+		{"Ethereum JSON", "eth.json", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Ethereum, "2", 2, output{format: formatJSON})
+		}},
+		{"Ethereum NDJSON", "eth.ndjson", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Ethereum, "2", 2, output{format: formatNDJSON})
+		}},
+		{"Ethereum CSV", "eth.csv", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Ethereum, "2", 2, output{format: formatCSV})
+		}},
+		{"Bitcoin range text", "btc-range.txt", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Bitcoin, "first..first+1", 2, output{format: formatText})
+		}},
+		{"Bitcoin range JSON", "btc-range.json", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Bitcoin, "first..first+1", 2, output{format: formatJSON})
+		}},
+		{"Ethereum range CSV", "eth-range.csv", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Ethereum, "last-1..last", 48, output{format: formatCSV})
+		}},
+		{"Bitcoin public keys CSV", "btc-public-keys.csv", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Bitcoin, "1", 2, output{format: formatCSV, publicKeys: true})
+		}},
+		{"Ethereum public keys text", "eth-public-keys.txt", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Ethereum, "1", 2, output{format: formatText, publicKeys: true})
+		}},
+		{"Combined CSV", "all.csv", func(w *bytes.Buffer) error {
+			return printKeys(w, keys.Combined, "1", 2, output{format: formatCSV})
+		}},
+		{"Combined JSON", "all.json", func(w *bytes.Buffer) error {
+			return printKeys(w, onNetwork(keys.Combined, "testnet"), "last", 3, output{format: formatJSON})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got bytes.Buffer
+
+			if err := tt.print(&got); err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", tt.golden)
+
+			if *update {
+				if err := ioutil.WriteFile(golden, got.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("Expected:\n%s", want)
+				t.Errorf("Actual:\n%s", got.Bytes())
+			}
+		})
+	}
+}
+
+// onNetwork returns a coin on a network, for the test tables
+func onNetwork(coin keys.Coin, network string) keys.Coin {
+	coin, err := coin.Network(network)
+	if err != nil {
+		panic(err)
+	}
+
+	return coin
+}
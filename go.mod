@@ -1,6 +1,6 @@
 module github.com/leporel/keys-generator
 
-go 1.15
+go 1.16
 
 require (
 	github.com/btcsuite/btcd v0.21.0-beta
@@ -3,24 +3,10 @@ package main
 import (
 	"bufio"
 	"fmt"
-	"log"
-	"math/big"
 	"os"
 	"sync"
 )
 
-var one = big.NewInt(1)
-
-func makeBigInt(number string) *big.Int {
-	i, success := new(big.Int).SetString(number, 10)
-
-	if !success {
-		log.Fatal("Failed to create BigInt from string")
-	}
-
-	return i
-}
-
 func readLines(path string) ([]string, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -0,0 +1,123 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// Litecoin, Dogecoin and Dash use the keys of Bitcoin with their own version bytes, their pages list
+// the seeds of Bitcoin pages. Only the fields that are used for keys and addresses are set.
+var (
+	// Litecoin is the coin of Litecoin pages, it has P2PKH, P2SH-P2WPKH and ltc1 P2WPKH addresses
+	Litecoin Coin = newAltCoin("ltc", "Litecoin", []altNetwork{
+		{"mainnet", altParams("litecoin-mainnet", 0x30, 0x32, 0xb0, "ltc")},
+		{"testnet", altParams("litecoin-testnet", 0x6f, 0x3a, 0xef, "tltc")},
+	})
+	// Dogecoin is the coin of Dogecoin pages, it only has P2PKH addresses
+	Dogecoin Coin = newAltCoin("doge", "Dogecoin", []altNetwork{
+		{"mainnet", altParams("dogecoin-mainnet", 0x1e, 0x16, 0x9e, "")},
+		{"testnet", altParams("dogecoin-testnet", 0x71, 0xc4, 0xf1, "")},
+	})
+	// Dash is the coin of Dash pages, it only has P2PKH addresses
+	Dash Coin = newAltCoin("dash", "Dash", []altNetwork{
+		{"mainnet", altParams("dash-mainnet", 0x4c, 0x10, 0xcc, "")},
+		{"testnet", altParams("dash-testnet", 0x8c, 0x13, 0xef, "")},
+	})
+)
+
+// altNetwork is a network of a coin that is derived like Bitcoin
+type altNetwork struct {
+	name   string
+	params *chaincfg.Params
+}
+
+func altParams(name string, pubKeyHashAddrID, scriptHashAddrID, privateKeyID byte, bech32HRPSegwit string) *chaincfg.Params {
+	return &chaincfg.Params{
+		Name:             name,
+		PubKeyHashAddrID: pubKeyHashAddrID,
+		ScriptHashAddrID: scriptHashAddrID,
+		PrivateKeyID:     privateKeyID,
+		Bech32HRPSegwit:  bech32HRPSegwit,
+	}
+}
+
+// altCoin is a coin whose rows have the Bitcoin columns that the coin has, encoded with the
+// parameters of its network. Coins without a bech32 prefix have no SegWit columns, none has
+// Taproot columns.
+type altCoin struct {
+	name     string
+	title    string
+	networks []altNetwork
+	network  altNetwork
+}
+
+func newAltCoin(name, title string, networks []altNetwork) altCoin {
+	return altCoin{name: name, title: title, networks: networks, network: networks[0]}
+}
+
+func (c altCoin) Name() string {
+	return c.name
+}
+
+func (c altCoin) Title() string {
+	return c.title
+}
+
+func (c altCoin) Networks() []string {
+	var names []string
+	for _, network := range c.networks {
+		names = append(names, network.name)
+	}
+
+	return names
+}
+
+func (c altCoin) Network(name string) (Coin, error) {
+	if name == "" {
+		name = c.networks[0].name
+	}
+
+	for _, network := range c.networks {
+		if network.name == name {
+			c.network = network
+
+			return c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown %s network %q, expected %s", strings.ToLower(c.title), name, orList(c.Networks()))
+}
+
+func (altCoin) Keyspace(keysPerPage int) (*Keyspace, error) {
+	return BitcoinKeyspace(keysPerPage)
+}
+
+func (c altCoin) Columns() []string {
+	columns := []string{"private", "compressed", "uncompressed"}
+
+	if c.network.params.Bech32HRPSegwit != "" {
+		columns = append(columns, "nativeSegwit", "nestedSegwit", "redeemScript")
+	}
+
+	return append(columns, "privateCompressed")
+}
+
+// Row returns the fields of the Bitcoin key of the seed that are in the columns of the coin
+func (c altCoin) Row(seed *big.Int) []string {
+	key := bitcoinKeyFromSeed(seed, c.network.params)
+
+	values := []string{key.Private, key.Compressed, key.Uncompressed}
+
+	if c.network.params.Bech32HRPSegwit != "" {
+		values = append(values, key.NativeSegwit, key.NestedSegwit, key.RedeemScript)
+	}
+
+	return append(values, key.PrivateCompressed)
+}
+
+func (c altCoin) ParsePrivateKey(input string) (PrivateKey, error) {
+	return ParseNetworkPrivateKey(input, c.network.params)
+}
@@ -0,0 +1,119 @@
+package keys
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// TestAltCoin_Row checks the row of seed 1 of every network. The addresses encode the hash160 of
+// the compressed and the uncompressed public key of seed 1 with the version bytes of the network.
+func TestAltCoin_Row(t *testing.T) {
+	tests := []struct {
+		name    string
+		coin    Coin
+		network string
+		wantRow []string
+	}{
+		{
+			"It can generate Litecoin keys",
+			Litecoin,
+			"mainnet",
+			[]string{"6u823ozcyt2rjPH8Z2ErsSXJB5PPQwK7VVTwwN4mxLBFrao69XQ", "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ", "LYWKqJhtPeGyBAw7WC8R3F7ovxtzAiubdM", "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", "MR8UQSBr5ULwWheBHznrHk2jxyxkHQu8vB", "0014751e76e8199196d454941c45d1b3a323f1433bd6", "T33ydQRKp4FCW5LCLLUB7deioUMoveiwekdwUwyfRDeGZm76aUjV"},
+		},
+		{
+			"It can generate Litecoin testnet keys",
+			Litecoin,
+			"testnet",
+			[]string{"91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "mtoKs9V381UAhUia3d7Vb9GNak8Qvmcsme", "tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0", "QdqJHJa9kv3x4AksVMTQAkD3122J1Pbb8p", "0014751e76e8199196d454941c45d1b3a323f1433bd6", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA"},
+		},
+		{
+			"It can generate Dogecoin keys",
+			Dogecoin,
+			"mainnet",
+			[]string{"6J8csdv3eDrnJcpSEb4shfjMh2JTiG9MKzC1Yfge4Y4GyUsjdM6", "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE", "DJRU7MLhcPwCTNRZ4e8gJzDebtG1H5M7pc", "QNcdLVw8fHkixm6NNyN6nVwxKek4u7qrioRbQmjxac5TVoTtZuot"},
+		},
+		{
+			"It can generate Dogecoin testnet keys",
+			Dogecoin,
+			"testnet",
+			[]string{"95UQWtAhNXzaVi3yUhaosHVdhVR4fi62e34wo6n3ZvcTw67if2k", "nesRpRaAbTDmZHwmzBkLd2AtF7Z9L9z5S2", "nhUXqN5cYNPvLLzk6Tn8ZPowqkeJP7Qws2", "cejxntqoC3o8qiC8HG8DrwoNyiRDBrMCEU8QrUVpLKdXsGy8LpTM"},
+		},
+		{
+			"It can generate Dash keys",
+			Dash,
+			"mainnet",
+			[]string{"7qYrzJZWqnyCWMYswFcqaRJypGdVceudXPSxmZKsngN7fyo7aAV", "XmN7PQYWKn5MJFna5fRYgP6mxT2F7xpekE", "XoyDQM3xGhFW5JqYBwTLckjqZ67Q3jZfAL", "XBHddvWWiMu3nZhhpTXBQWJMmdz5JNKJD85b9fgKAckCT2coW3Y4"},
+		},
+		{
+			"It can generate Dash testnet keys",
+			Dash,
+			"testnet",
+			[]string{"91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx", "yWziQMcwmKjRdzi7eWjwiQX8EjWcd6dSg6", "yZbpRJ8PiEuaR3m5knmjenABqNbmZpwn7k", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coin, err := tt.coin.Network(tt.network)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := coin.Row(big.NewInt(1)); !reflect.DeepEqual(got, tt.wantRow) {
+				t.Errorf("Expected: %v", tt.wantRow)
+				t.Errorf("Actual:   %v", got)
+			}
+		})
+	}
+}
+
+func TestAltCoin_Network(t *testing.T) {
+	coin, err := Litecoin.Network("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := coin.Row(big.NewInt(1))[1]; got != "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ" {
+		t.Errorf("Expected the default network to be mainnet, got %v", got)
+	}
+
+	want := `unknown dogecoin network "signet", expected mainnet or testnet`
+	if _, err := Dogecoin.Network("signet"); err == nil || err.Error() != want {
+		t.Errorf("Expected: %v", want)
+		t.Errorf("Actual:   %v", err)
+	}
+}
+
+func TestAltCoin_ParsePrivateKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		coin       Coin
+		input      string
+		wantFormat KeyFormat
+		wantErr    error
+	}{
+		{"It can parse a Litecoin WIF", Litecoin, "6u823ozcyt2rjPH8Z2ErsSXJB5PPQwK7VVTwwN4mxLBFrao69XQ", KeyFormatWIF, nil},
+		{"It can parse a compressed Litecoin WIF", Litecoin, "T33ydQRKp4FCW5LCLLUB7deioUMoveiwekdwUwyfRDeGZm76aUjV", KeyFormatCompressedWIF, nil},
+		{"It can parse a compressed Dogecoin WIF", Dogecoin, "QNcdLVw8fHkixm6NNyN6nVwxKek4u7qrioRbQmjxac5TVoTtZuot", KeyFormatCompressedWIF, nil},
+		{"It can parse a compressed Dash WIF", Dash, "XBHddvWWiMu3nZhhpTXBQWJMmdz5JNKJD85b9fgKAckCT2coW3Y4", KeyFormatCompressedWIF, nil},
+		{"It can parse a hex key", Dash, "0x1", KeyFormatHex, nil},
+		{"It rejects a Bitcoin WIF", Litecoin, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "", ErrMalformedKey},
+		{"It rejects a WIF of another coin", Dogecoin, "T33ydQRKp4FCW5LCLLUB7deioUMoveiwekdwUwyfRDeGZm76aUjV", "", ErrMalformedKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.coin.ParsePrivateKey(tt.input)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+
+			if err == nil && (got.Format != tt.wantFormat || got.Seed.Cmp(one) != 0) {
+				t.Errorf("Expected: 1 %v", tt.wantFormat)
+				t.Errorf("Actual:   %v %v", got.Seed, got.Format)
+			}
+		})
+	}
+}
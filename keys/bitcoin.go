@@ -0,0 +1,226 @@
+package keys
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"math/big"
+)
+
+// largestBitcoinSeed is the last seed on Bitcoin pages, the largest valid private key
+var largestBitcoinSeed = new(big.Int).Sub(curveOrder, one)
+
+// signetParams only differs from testnet in fields that are not used for keys and addresses,
+// this version of btcd does not ship signet parameters.
+var signetParams = func() chaincfg.Params {
+	params := chaincfg.TestNet3Params
+	params.Name = "signet"
+
+	return params
+}()
+
+var bitcoinNetworks = map[string]*chaincfg.Params{
+	"mainnet": &chaincfg.MainNetParams,
+	"testnet": &chaincfg.TestNet3Params,
+	"signet":  &signetParams,
+	"regtest": &chaincfg.RegressionNetParams,
+}
+
+// BitcoinNetwork returns the chain parameters for a network name: mainnet, testnet, signet or regtest.
+// An empty name means mainnet.
+func BitcoinNetwork(name string) (*chaincfg.Params, error) {
+	if name == "" {
+		return &chaincfg.MainNetParams, nil
+	}
+
+	params, ok := bitcoinNetworks[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown bitcoin network %q, expected mainnet, testnet, signet or regtest", name)
+	}
+
+	return params, nil
+}
+
+// BitcoinKey is one row of a Bitcoin page. The uncompressed WIF in Private imports as the Uncompressed
+// address, the compressed WIF in PrivateCompressed imports as every other address in the row.
+type BitcoinKey struct {
+	// Private is the uncompressed WIF (5… on mainnet)
+	Private string
+	// Compressed is the P2PKH address of the compressed public key
+	Compressed string
+	// Uncompressed is the P2PKH address of the uncompressed public key
+	Uncompressed string
+	// NativeSegwit is the P2WPKH address (bc1q… on mainnet)
+	NativeSegwit string
+	// XOnlyPubKey is the hex encoded BIP340 x-only public key
+	XOnlyPubKey string
+	// Taproot is the BIP86 key-path-only P2TR address (bc1p… on mainnet)
+	Taproot string
+	// NestedSegwit is the P2SH-P2WPKH address (3… on mainnet)
+	NestedSegwit string
+	// RedeemScript is the hex encoded redeem script of NestedSegwit
+	RedeemScript string
+	// PrivateCompressed is the compressed WIF (K… or L… on mainnet)
+	PrivateCompressed string
+}
+
+// Values returns the fields of the key in the order of the columns of Bitcoin
+func (k BitcoinKey) Values() []string {
+	return []string{k.Private, k.Compressed, k.Uncompressed, k.NativeSegwit, k.XOnlyPubKey, k.Taproot, k.NestedSegwit, k.RedeemScript, k.PrivateCompressed}
+}
+
+// Bitcoin is the coin of Bitcoin pages, on mainnet until another network is selected
+var Bitcoin Coin = bitcoinCoin{params: &chaincfg.MainNetParams}
+
+type bitcoinCoin struct {
+	params *chaincfg.Params
+}
+
+func (bitcoinCoin) Name() string {
+	return "btc"
+}
+
+func (bitcoinCoin) Title() string {
+	return "Bitcoin"
+}
+
+func (bitcoinCoin) Networks() []string {
+	return []string{"mainnet", "testnet", "signet", "regtest"}
+}
+
+func (bitcoinCoin) Network(name string) (Coin, error) {
+	params, err := BitcoinNetwork(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return bitcoinCoin{params: params}, nil
+}
+
+func (bitcoinCoin) Keyspace(keysPerPage int) (*Keyspace, error) {
+	return BitcoinKeyspace(keysPerPage)
+}
+
+func (bitcoinCoin) Columns() []string {
+	return []string{"private", "compressed", "uncompressed", "nativeSegwit", "xOnlyPubKey", "taproot", "nestedSegwit", "redeemScript", "privateCompressed"}
+}
+
+func (c bitcoinCoin) Row(seed *big.Int) []string {
+	return bitcoinKeyFromSeed(seed, c.params).Values()
+}
+
+func (c bitcoinCoin) ParsePrivateKey(input string) (PrivateKey, error) {
+	return ParseNetworkPrivateKey(input, c.params)
+}
+
+// GenerateBitcoinKeys returns the keys on a page for the given network. The last page is returned
+// short, pages after it return ErrPageOutOfRange.
+func GenerateBitcoinKeys(pageNumber string, keysPerPage int, params *chaincfg.Params) ([]BitcoinKey, error) {
+	keyspace, err := BitcoinKeyspace(keysPerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := keyspace.Page(pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	firstSeed, count := keyspace.PageSeeds(page)
+
+	var bitcoinKeys []BitcoinKey
+
+	for i := 0; i < count; i++ {
+		bitcoinKeys = append(bitcoinKeys, bitcoinKeyFromSeed(firstSeed, params))
+
+		firstSeed.Add(firstSeed, one)
+	}
+
+	return bitcoinKeys, nil
+}
+
+func bitcoinKeyFromSeed(seed *big.Int, params *chaincfg.Params) BitcoinKey {
+	// The WIFs encode the seed as it is listed, even when it is not below the curve order
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), seedBytes(seed))
+
+	wif, _ := btcutil.NewWIF(privKey, params, false)
+	cwif, _ := btcutil.NewWIF(privKey, params, true)
+
+	key := BitcoinKey{
+		Private:           wif.String(),
+		PrivateCompressed: cwif.String(),
+	}
+
+	scalar, status := SeedScalar(seed)
+	if status == SeedInvalid {
+		return key
+	}
+
+	_, public := btcec.PrivKeyFromBytes(btcec.S256(), seedBytes(scalar))
+
+	// Get compressed and uncompressed addresses for public key
+	caddr, _ := btcutil.NewAddressPubKey(public.SerializeCompressed(), params)
+	uaddr, _ := btcutil.NewAddressPubKey(public.SerializeUncompressed(), params)
+
+	key.Compressed = caddr.EncodeAddress()
+	key.Uncompressed = uaddr.EncodeAddress()
+
+	// Networks without a bech32 prefix have no SegWit, see altCoin
+	if params.Bech32HRPSegwit == "" {
+		return key
+	}
+
+	// SegWit addresses are always derived from the compressed public key
+	pubKeyHash := btcutil.Hash160(public.SerializeCompressed())
+
+	// Get the nested SegWit (P2SH-P2WPKH) address, the redeem script is "OP_0 <20 byte pubkey hash>"
+	redeemScript := append([]byte{0x00, 0x14}, pubKeyHash...)
+	saddr, _ := btcutil.NewAddressScriptHash(redeemScript, params)
+
+	// Get the native SegWit (P2WPKH) address
+	waddr, _ := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, params)
+
+	// Get the BIP86 key-path-only Taproot (P2TR) address
+	taddr, _ := encodeTaprootAddress(params.Bech32HRPSegwit, taprootOutputKey(public))
+
+	key.NestedSegwit = saddr.EncodeAddress()
+	key.RedeemScript = hex.EncodeToString(redeemScript)
+	key.NativeSegwit = waddr.EncodeAddress()
+	key.XOnlyPubKey = hex.EncodeToString(xOnlyPubKey(public))
+	key.Taproot = taddr
+
+	return key
+}
+
+// FindBtcWifPage returns the page that a WIF is on. It accepts both uncompressed and compressed
+// WIFs, they encode the same seed.
+func FindBtcWifPage(wifString string, keysPerPage int, params *chaincfg.Params) (string, error) {
+	location, err := LocateBtcWif(wifString, keysPerPage, params)
+	if err != nil {
+		return "", err
+	}
+
+	return location.Page.String(), nil
+}
+
+// LocateBtcWif returns the page, the index and the seed of a WIF
+func LocateBtcWif(wifString string, keysPerPage int, params *chaincfg.Params) (KeyLocation, error) {
+	wif, err := btcutil.DecodeWIF(wifString)
+
+	if err != nil {
+		return KeyLocation{}, fmt.Errorf("%w: could not decode WIF: %v", ErrMalformedKey, err)
+	}
+
+	if !wif.IsForNet(params) {
+		return KeyLocation{}, fmt.Errorf("%w: WIF is not for %s", ErrMalformedKey, params.Name)
+	}
+
+	keyspace, err := BitcoinKeyspace(keysPerPage)
+	if err != nil {
+		return KeyLocation{}, err
+	}
+
+	return keyspace.LocateKey(wif.PrivKey.D)
+}
@@ -0,0 +1,383 @@
+package keys
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestGenerateBitcoinKeys(t *testing.T) {
+	type args struct {
+		pageNumber  string
+		keysPerpage int
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		wantKeys []BitcoinKey
+	}{
+		{
+			"It can generate keys starting from the first seed",
+			args{"1", 10},
+			[]BitcoinKey{
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", Compressed: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", Uncompressed: "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm", NestedSegwit: "3JvL6Ymt8MVWiCNHC7oWU6nLeHNJKLZGLN", RedeemScript: "0014751e76e8199196d454941c45d1b3a323f1433bd6", NativeSegwit: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", XOnlyPubKey: "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", Taproot: "bc1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5sspknck9"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAvUcVfH", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU74NMTptX4", Compressed: "1cMh228HTCiwS8ZsaakH8A8wze1JR5ZsP", Uncompressed: "1LagHJk2FyCV2VzrNHVqg3gYG4TSYwDV4m", NestedSegwit: "3FWHHE3RVgyv5vYmMrcoRdA25uugWvQbso", RedeemScript: "001406afd46bcdfd22ef94ac122aa11f241244a37ecc", NativeSegwit: "bc1qq6hag67dl53wl99vzg42z8eyzfz2xlkvxechjp", XOnlyPubKey: "c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5", Taproot: "bc1pet7ep3czdu9k4wvdlz2fp5p8x2yp7t6ttyqg2c6cmh0lgeuu9lasmp9hsg"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreB1FQ8BZ", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU74sHUHy8S", Compressed: "1CUNEBjYrCn2y1SdiUMohaKUi4wpP326Lb", Uncompressed: "1NZUP3JAc9JkmbvmoTv7nVgZGtyJjirKV1", NestedSegwit: "3BM3eLQZbwubG3XwwxJmd9qxwMJn7yUTSn", RedeemScript: "00147dd65592d0ab2fe0d0257d571abf032cd9db93dc", NativeSegwit: "bc1q0ht9tyks4vh7p5p904t340cr9nvahy7u3re7zg", XOnlyPubKey: "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9", Taproot: "bc1pgxxyvcmdncdxs06cudd5yvmwwahaesaj6n3eu7st7x4sw9hrchaqjy33gs"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreB4AD8Yi", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU75NBY2dKG", Compressed: "1JtK9CQw1syfWj1WtFMWomrYdV3W2tWBF9", Uncompressed: "1MnyqgrXCmcWJHBYEsAWf7oMyqJAS81eC", NestedSegwit: "36mwXuH4FVaeLuMUsmyU7YvVXKCcuZyP5N", RedeemScript: "0014c42e7ef92fdb603af844d064faad95db9bcdfd3d", NativeSegwit: "bc1qcsh8a7f0mdsr47zy6pj04tv4mwdumlfaslcy8n", XOnlyPubKey: "e493dbf1c10d80f3581e4904930b1404cc6c13900ee0758474fa94abe8c4cd13", Taproot: "bc1pjvtc2mkj9vmfneuj7w9dsqle70a040ms5tyfswhhz4vjyskznj5ql45vlj"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreBF8or94", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU75s2EPgZf", Compressed: "17Vu7st1U1KwymUKU4jJheHHGRVNqrcfLD", Uncompressed: "1E1NUNmYw1G5c3FKNPd435QmDvuNG3auYk", NestedSegwit: "36UVqWe99RXE1aT6K7hVJ6jHqkw2iRCA4h", RedeemScript: "00144747e8746cddb33b0f7f95a90f89f89fb387cbb6", NativeSegwit: "bc1qgar7sarvmkenkrmljk5slz0cn7ec0jakk4qa7y", XOnlyPubKey: "2f8bde4d1a07209355b4a7250a5c5128e88b84bddc619ab7cba8d569b240efe4", Taproot: "bc1paecncecu260mkwvsr63lw5v4s496v9gfn2en56hv4f0d2w2j97fsmfm28s"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreBKdE2NK", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU76Myig6zj", Compressed: "1Cf2hs39Woi61YNkYGUAcohL2K2q4pawBq", Uncompressed: "1UCZSVufT1PNimutbPdJUiEyCYSiZAD6n", NestedSegwit: "3LKyvRN6SmYXGBNn8fcQvYxW9MGKtwcinN", RedeemScript: "00147fda9cf020c16cacf529c87d8de89bfc70b8c9cb", NativeSegwit: "bc1q0ldfeupqc9k2eaffep7cm6yml3ct3jwtwzqt7k", XOnlyPubKey: "fff97bd5755eeea420453a14355235d382f6472f8568a18b2f057a1460297556", Taproot: "bc1p4rsld9ryjhte00drc0r23r8ngd63xrzh5s4fvmy6q5yt70xzlsdqcuvtzv"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreBR6zCMU", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU76rnZwVdz", Compressed: "19ZewH8Kk1PDbSNdJ97FP4EiCjTRaZMZQA", Uncompressed: "1BYbgHpSKQCtMrQfwN6b6n5S718EJkEJ41", NestedSegwit: "3BW9kFfY5TGhjVoRtxoijfjJvfggZgfNDX", RedeemScript: "00145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69", NativeSegwit: "bc1qthklh702txwafc72d2qtxv7ywt7sk0mfy3mw6y", XOnlyPubKey: "5cbdf0646e5db4eaa398f365f2ea7a0e3d419b7e0330e39ce92bddedcac4f9bc", Taproot: "bc1pr896td3mmjl5vc57mutumgfmeet8hyzy9w6zr6hg5vhrtppevlpsp3x3nm"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreBbMaQX1", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU77MfhviY5", Compressed: "1EhqbyUMvvs7BfL8goY6qcPbD6YKfPqb7e", Uncompressed: "1JMcEcKXQ7xA7JLAMPsBmHz68bzugYtdrv", NestedSegwit: "3PNNDgsXkAwe6jZScfShL3KapB6FhFePRH", RedeemScript: "00149652d86bedf43ad264362e6e6eba6eb764508127", NativeSegwit: "bc1qjefds6ld7sadyepk9ehxawnwkaj9pqf8xuq2eg", XOnlyPubKey: "2f01e5e15cca351daff3843fb70f3c2f0a1bdd05e5af888a67784ef3e10a2a01", Taproot: "bc1p5ju3f0m0dz3y4hgynde804krzz2grxr8chytzgew4fxmk5lunzlqwe8xsx"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreBd7uGcN", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU77rcWepLD", Compressed: "1HSxWThjiwbC4dJbXHMpBfwRenB12UguG5", Uncompressed: "1CijKR7rDvJJBJfSPyUYrWC8kAsQLy2B2e", NestedSegwit: "38i21t9QR486kSnbPX7ByoLhdMhJVFnXcq", RedeemScript: "0014b46abf4d9e1746e33bcc39cea3de876c29c4adf3", NativeSegwit: "bc1qk34t7nv7zarwxw7v88828h58ds5uft0nfkn84r", XOnlyPubKey: "acd484e2f0c7f65309ad178a9f559abde09796974c57e714c35f110dfc27ccbe", Taproot: "bc1pan4szzggtg9rudp5lt4tyr5aprjmflwg2wxy8zarue6gxjw47f0s7sdrjj"},
+				{Private: "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreBoNWTw6", PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU78MReK4ms", Compressed: "13DaZ9nfmJLfzU6oBnD2sdCiDmf3M5fmLx", Uncompressed: "1GDWJm5dPj6JTxF68WEVhicAS4gS3pvjo7", NestedSegwit: "3KeHtTBG4Z6c4ou7avUaxNTZedRHRbZVfu", RedeemScript: "0014185140bb54704a9e735016faa7a8dbee4449bddc", NativeSegwit: "bc1qrpg5pw65wp9fuu6szma202xmaezyn0wumxh02v", XOnlyPubKey: "a0434d9e47f3c86235477c7b1ae6ae5d3442d49b1943c2b752a68e2a47e247c7", Taproot: "bc1p5mmme8n7pqk4x55sky33h3xxu0hp9tnuszt78szmhv8su25a4y3smy8tg3"},
+			},
+		},
+		{
+			"It can generate keys for the last page",
+			args{"904625697166532776746648320380374280100293470930272690489102837043110636675", 128},
+			[]BitcoinKey{
+				// 64 keys
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqemizF9vA", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jhxzoLCTS", Compressed: "12d8ggXP5MSJoEuqtRJqyZpLxqUAztmrpH", Uncompressed: "1PDSZN2qgFcuay1vVRxYo1yp9gfXeSKJgt", NestedSegwit: "34rBMssLi3kvyzJhfcYmSr1d1xZhviKNNs", RedeemScript: "001411cd83cb8690772ec92e2f78d4b24fb1582f4764", NativeSegwit: "bc1qz8xc8juxjpmjajfw9audfvj0k9vz73mym3ftam", XOnlyPubKey: "bf23c1542d16eab70b1051eaf832823cfc4c6f1dcdbafd81e37918e6f874ef8b", Taproot: "bc1p4ly2eguqanm3trfg9glphycc6unnh8n5vaefljujgh8nwnhh9z7slnrhhd"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqemmsbvAo", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jiTqbiSZp", Compressed: "1Et3i5Bjbn5cLbqwngT3HeSxQG3sXyvC7L", Uncompressed: "1EsDryguZoanBraPCYCk9bUoynfY6PoNvj", NestedSegwit: "38sEBVTeUJvypRzsVpSUWYvp5rNjHDTcii", RedeemScript: "001498411c213f5137156020fe9d06b39b2f47c07039", NativeSegwit: "bc1qnpq3cgfl2ym32cpql6wsdvum9aruqupevjkfqa", XOnlyPubKey: "e3e6bd1071a1e96aff57859c82d570f0330800661d1c952f9fe2694691d9b9e8", Taproot: "bc1pnfxu98jj0t709pylg34gsaj8nn5pkyg6tzm9quhx6rnq2qna2v8qc0ca3v"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqemtri4qS", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jixoD2o6A", Compressed: "1MJu8dVQVRx6AeSLuHA9avGQALqxGFB4iw", Uncompressed: "1FKs7XQkQS5MHqEmFeKmx9vhpBRNYUxBn5", NestedSegwit: "3BmZqPRbUaTt3YK8TtGyhcGAVqXbvYXWgQ", RedeemScript: "0014dec51aaf8fe86726a582d7032771add8464acb28", NativeSegwit: "bc1qmmz34tu0apnjdfvz6upjwuddmpry4jegvxgau5", XOnlyPubKey: "108443b948d1553584a271333f7fbd043c4d66a91706edecbf07f6894c04f299", Taproot: "bc1puwd5zla7w5a0g77seydmt78ru8pkznh9w992g3rxvfcldjrjnk8skmv56u"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqemyyKQGD", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jjTcUDB88", Compressed: "16qmCy9t35haJZfbnq4PkXfeKMjNQvx5h", Uncompressed: "1GkQuui5ofmtJMnQvrMzVs3Rw2qnBj8Hms", NestedSegwit: "37RFZxNViYMZ2Qn1eGrGqs2ESh1dzwjGYu", RedeemScript: "0014011ac8d01de24da3c36fa3fea18876895111477a", NativeSegwit: "bc1qqydv35qaufx68sm050l2rzrk39g3z3m65ltupl", XOnlyPubKey: "754e3239f325570cdbbf4a87deee8a66b7f2b33479d468fbc1a50743bf56cc18", Taproot: "bc1px5smq9ppm6z7mgp3rrrx7h2e7xvsxnr4ql7hhqf9aucupfn7xm3q79yw5t"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqenBBbpLQ", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jjxZYP2va", Compressed: "1DHQUMNsRoZiCpcd7PhmHgrQvDUPGwGptK", Uncompressed: "13bFiKHMPA6ydmC4jctqqdvRNPHq8JLhQc", NestedSegwit: "3MByqurXbaX82wsiai2FbMFUcAccMWakaa", RedeemScript: "001486bbc20446e634d92c66226e6853ea900aad72c3", NativeSegwit: "bc1qs6auypzxuc6djtrxyfhxs5l2jq926ukr9s5zwc", XOnlyPubKey: "01257e93a78a5b7d8fe0cf28ff1d8822350c778ac8a30e57d2acfc4d5fb8c192", Taproot: "bc1pp0esm4gj6ugfxe7ufadcg5wsd9pl05h0uddxr3na3666v3zfammq6lctms"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqenEc4n5A", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jkTRVQvjg", Compressed: "1MiUJRU3fSSgvSeF56BjMZaFHjcWmZS8w", Uncompressed: "16gK4BTErckvm22uqTAcbztsEzdRq4JwT4", NestedSegwit: "3CBF39KGhcXMRMCoxwXjibC24wD7okggiF", RedeemScript: "001403eaefee4710877b07c0e7457a795f8bd5ecb016", NativeSegwit: "bc1qq04wlmj8zzrhkp7quazh572l3027evqk5z2jy9", XOnlyPubKey: "7635ca72d7e8432c338ec53cd12220bc01c48685e24f7dc8c602a7746998e435", Taproot: "bc1psmf5aq76x0ksk6cqqas8vmwg8gewgnqc09w6xf5ehcka2zmjd7lqprkwru"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqenQFmCR7", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jkxHLFnwr", Compressed: "1HWyLvUVJvkwmFgF2SvPkhHA5ttRhjGR1h", Uncompressed: "1QeXZe66ay57kpkjxT6ydcpRe5J1TA997", NestedSegwit: "3PsKmdPfuJDDqNoSRn1XmvhRmCff7ws1wX", RedeemScript: "0014b52d1bcd62211475b60b2d898b35d288f74ef2ce", NativeSegwit: "bc1qk5k3hntzyy28tdst9kyckdwj3rm5aukwll5gyv", XOnlyPubKey: "45562f033698faca1540cbc9bf962cf4764c1ef4094ee4b6742b761c49b46d3b", Taproot: "bc1pe0q4sqkj2x3cqfns59ejtdhc6sqmuaqn5mnc72ef5azkecfpc2ksfgsnph"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqenVqqaRt", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jmT9n5WXx", Compressed: "1DPinkkKGeh4B5Qynr1aHwfGPz38BBCd67", Uncompressed: "18FeyYSiZBLvsSuKVtwDugRCvvtVU4t4LE", NestedSegwit: "3QSA3GpwDRiE1qMPQK2UbbdoaxBWjwcH3K", RedeemScript: "001487ed8b4da82c006d3e43f0fbf863da141bac19da", NativeSegwit: "bc1qslkckndg9sqx60jr7ralsc76zsd6cxw668hc9u", XOnlyPubKey: "2600ca4b282cb986f85d0f1709979d8b44a09c07cb86d7c124497bc86f082120", Taproot: "bc1pnyw4w5glwrsr5pyxh4rsqqyuzkpu962wd46zejnpmr7yuqgl80ks7t538h"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqencAZErF", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jmx4ionp7", Compressed: "1BPdPPj9jgtHT3usnF8AizRfnbXVVFPVDT", Uncompressed: "126uVWnkbykXpUzNEuk7erFyuMYaePSWoV", NestedSegwit: "3PJmE1i1dLQN3RbWjWWSNcCgsjM4TbVYZa", RedeemScript: "001471f8c85590874ab9d2058b07d108f65ad7093f8e", NativeSegwit: "bc1qw8uvs4vssa9tn5s93vrazz8ktttsj0uwnhcznk", XOnlyPubKey: "bce74de6d5f98dc027740c2bbff05b6aafe5fd8d103f827e48894a2bd3460117", Taproot: "bc1pvudd3mkz6qhneuze4t0ehxe99mj8mprpjkr7endunkm3ajw87r3qlw2gu4"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqenfnqBWw", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jnSvBc67Q", Compressed: "1CvKupTzRqsDi5Zf4QdbVYhmaQUkF667hM", Uncompressed: "1Cud5ZFu44376mtdGytFVQxoXZFsAf396W", NestedSegwit: "3JprnWBFS2fMKwexUvazPfoY6RfqMLiSYV", RedeemScript: "001482bf3725df95cd4260b003d21063a1b85a66ab21", NativeSegwit: "bc1qs2lnwfwljhx5yc9sq0fpqcaphpdxd2epscthue", XOnlyPubKey: "caf754272dc84563b0352b7a14311af55d245315ace27c65369e15f7151d41d1", Taproot: "bc1pu5mg32l7jmsc93jme0602temwfj74pr8h2yyemvzf6dr7dnjry2q86rzal"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqenripixH", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jnwmrCkom", Compressed: "1J5kaAUPpLZor6UVkTeJYtBojgXrtWsknv", Uncompressed: "129Zk4KrdjCtTkPDKDA9yKEoyQMKg7nnY4", NestedSegwit: "38zkyqe8wAtKcexUw7n3zgQSHYQpugX4AB", RedeemScript: "0014bb602c8f600cc0dc2518ad3f85ce833f95623aeb", NativeSegwit: "bc1qhdszermqpnqdcfgc45lctn5r872kywht59zmk2", XOnlyPubKey: "4fdcb8fa639cee441c8331fd47a2e5ff3447be24500ca7a5249971067c1d506b", Taproot: "bc1pd9nu3hmf327qnl6zt4txx34zjgj6d4tpcpj5e7mhe8rsglnlqtcq7zm7yd"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqenwBMVJd", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5joShDHVX4", Compressed: "1MYwjHMGQZjWFYnXmWMMnaueyn6fHCYL6L", Uncompressed: "1GahK7oUFETxTRp1tpcHt6EXchCerop1sj", NestedSegwit: "33u6V4n3AK3yybxa1ASX1tWrLRnHZTaphb", RedeemScript: "0014e16d1890b21347e1f54995379fac002682856391", NativeSegwit: "bc1qu9k33y9jzdr7ra2fj5meltqqy6pg2cu35ytzaa", XOnlyPubKey: "f16f804244e46e2a09232d4aff3b59976b98fac14328a2d1a32496b49998f247", Taproot: "bc1p0pnpk0dnypukqaljtxlr27uj9f5thfcwkujxh0nu2jveuc47792q0g5npc"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeo3RQvkv", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jowZDAaoi", Compressed: "18HrrgAZ3csXpqJevSembrbCv43UR2LoTo", Uncompressed: "1BTcZcviXTJSoHRxaQZwvPWeUCwJMqj9id", NestedSegwit: "3NtLZEKq9ndx7WSe4FevW3cXvxZ4hp8YTV", RedeemScript: "00144ff92769f08d4483d2266e5036ab492a94f15023", NativeSegwit: "bc1qflujw60s34zg853xdegrd26f9220z5prgylzjw", XOnlyPubKey: "2b22efda32491a9e0294339ca3da761f7d36cfc8814c1b29ca731921025ff695", Taproot: "bc1pxk9cv249pkjxq34hntezzmacn5qt4ng8wcm5wly0x6s58r9xvcls6mtkpt"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeo6TidXi", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jpSUGjzLm", Compressed: "19kvXX4hHGF9cTJmomrN6CBePfEhpKDRWP", Uncompressed: "1MadvbXBmgUo18XiwiS7w3nx4gPyHbGiqL", NestedSegwit: "37q15Gi39oig8TUAJfAfXgvbJ12dF1HSWc", RedeemScript: "0014600f92f3ac05cca489dbc89e922a6d00982bcca3", NativeSegwit: "bc1qvq8e9uavqhx2fzwmez0fy2ndqzvzhn9r9xumhf", XOnlyPubKey: "463b3d9f662621fb1b4be8fbbe2520125a216cdfc9dae3debcba4850c690d45b", Taproot: "bc1ptnt56gzx5s44apmf7jesyxm5rpzk2u6dxzjegv8846fspz2xc3fqhcnah6"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeoCq3htf", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jpwPDhJk2", Compressed: "15av1HesW2XF4hs8XP9aNGjezNnuJa3pjW", Uncompressed: "1DYHVPZKncADbTRUyqQ6vLzAzotJBBdNVZ", NestedSegwit: "37tHNHZKFbDM6FHgez8uNPcfwXxi5QF59G", RedeemScript: "0014324a79e6d5134c90eac2c19ddf1909beeaf1b703", NativeSegwit: "bc1qxf98nek4zdxfp6kzcxwa7xgfhm40rdcrv2lcdn", XOnlyPubKey: "29757774cc6f3be1d5f1774aefa8f02e50bc64404230e7a67e8fde79bd559a9a", Taproot: "bc1pu5tquz7pd5kz0h5m373adn72gtln4n7e3axpxyxwhve77rm20nnsxj9x0g"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeoQJAair", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jqSHj3bQd", Compressed: "15pTbF1pm6oEDHEMW4K1TUv3xdMUodTfWu", Uncompressed: "1CaZUpjd7VmsyWDFrk9WG9nTYMLcLLvvCw", NestedSegwit: "3FjKinyhZcU79fXYio3Axaq9LPARRsyhnc", RedeemScript: "001434da3ff720f2d338986747961f327c1288ca9d66", NativeSegwit: "bc1qxndrlaeq7tfn3xr8g7tp7vnuz2yv48tx2w0xqq", XOnlyPubKey: "f2dac991cc4ce4b9ea44887e5c7c0bce58c80074ab9d4dbaeb28531b7739f530", Taproot: "bc1plf7y7rs8y0z844yadzmtpg5rre439v4le07p99008edwnlt2tu4srzvtaw"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeoUG67kV", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jqw9vFUeV", Compressed: "158eqSFXqk53iyMnMZoENAE2o965Fe4dHy", Uncompressed: "1DdXcnmYs4zWryEvfXJJWqu86T4DbQD2a8", NestedSegwit: "3KScAjNR6cP2diQAEZ5amoV9XF37yo8foJ", RedeemScript: "00142d52ff7db9fa56782498d4038c6fa6210a9168d3", NativeSegwit: "bc1q94f07ldelft8sfyc6spccmaxyy9fz6xn2jlf64", XOnlyPubKey: "6eca335d9645307db441656ef4e65b4bfc579b27452bebc19bd870aa1118e5c3", Taproot: "bc1p4q33jaaqq4augwkajs3spmaulyxlkdugs3xm0qfc8hyd9tqtxcasvlkdze"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeodFUkKT", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jrRy4A6xj", Compressed: "1CqJeCZBiLkB3bSgGiRoEURSj6LGqVqqRg", Uncompressed: "1DZSj1cyJbhCzgz1UgTvPZHRZVvoGyDUAX", NestedSegwit: "3HpGKBecz31U5NieK2v2kCoF9pnPtcQZfR", RedeemScript: "001481cc13788af6640d4f7cd651d4ab4a6d3ed457ae", NativeSegwit: "bc1qs8xpx7y27ejq6nmu6egaf262d5ldg4awfmz6kw", XOnlyPubKey: "77f230936ee88cbbd73df930d64702ef881d811e0e1498e2f1c13eb1fc345d74", Taproot: "bc1py3fqrjtl80fjgwelkn4r3ek2qqfhah32yuzxm3y63asrlp8wegdsj6tn9v"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeog8LP2s", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jrvtGCxPn", Compressed: "1Ksi2xmc9vi5wnxNdxKkcs3pmsLQakoBBF", Uncompressed: "14jo3BJqdNzVJcz4YrF4EMYvHSGgwdYKYY", NestedSegwit: "39zQh1xGibKhgTT5NyYQMjooM7JKPuizcN", RedeemScript: "0014cf0950c4f094c95bfbc10ed6c1cfbba22b86b08f", NativeSegwit: "bc1qeuy4p38sjny4h77ppmtvrnam5g4cdvy0nrgpqr", XOnlyPubKey: "f8b0b03d44112259f903b3d100e3950d980fdde9c7e85701c16baedc90235717", Taproot: "bc1ps605dcwp73qh8r4tmhlwhwpelej86ngas6k5srf4shhcjs6unh4s7386n8"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeoqicdaf", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jsRjn4ZJK", Compressed: "1MydgvXarZNjDs8Nzh5SkR4LsJbSszAEEU", Uncompressed: "1DBXK2tjeJXdy128r6yhBqET55wPqSGSvc", NestedSegwit: "387APgL1XjVcFykNf5Nq7wfizcrqhMhaa5", RedeemScript: "0014e6186f76e035497f8ce67bd25f19b25d179803bb", NativeSegwit: "bc1qucvx7ahqx4yhlr8x00f97xdjt5tesqamt9amd2", XOnlyPubKey: "049370a4b5f43412ea25f514e8ecdad05266115e4a7ecb1387231808f8b45963", Taproot: "bc1puqexsq5lh445ap200p27xqyx8s8g8rxjf6vw65kru0vkp2nsu8hsl76s4z"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeovMf19o", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jsvdKBHaC", Compressed: "1968U6xwiis6ipAaE4uP7H985Sg2xPtPiL", Uncompressed: "1237sbJWPKg2MdZzuSqRaqEaaaKGXA1Cou", NestedSegwit: "37nRgSqXMeLGkErJwiDtEhrfQKosnkiiKk", RedeemScript: "001458b951c813decda1a713bedfcb74601e5cefbf32", NativeSegwit: "bc1qtzu4rjqnmmx6rfcnhm0ukarqrewwl0ej2j4px3", XOnlyPubKey: "5d045857332d5b9e541514731622af8d60c180165d971a61e06b70a9b3834765", Taproot: "bc1pqeaxzktv9me03a85dnlef45mygl80tdn58mxtpft5sla06fkgxxqzarj5y"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqep2apkpy", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jtRZQX6dU", Compressed: "14jbc7bNhF94oiWX5p8dSHP6UkyPhysYW4", Uncompressed: "1KnvaEg8NFdeRY3GjcUZm1NoVq8PcdNLcV", NestedSegwit: "36GJfBJ7Q8fFR5QUUz16iMXBe7Uq2snM2D", RedeemScript: "001428f6bc21835dbebad09b29ad8ec22224895b76cc", NativeSegwit: "bc1q9rmtcgvrtklt45ym9xkcas3zyjy4kakvxudsp4", XOnlyPubKey: "d528ecd9b696b54c907a9ed045447a79bb408ec39b68df504bb51f459bc3ffc9", Taproot: "bc1phlazt5frvq02xys3xk6shzxgqxyvlwtz9lalew2w224cc8t3krus2hwjys"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqep9EVtUJ", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jtvPxG114", Compressed: "14csyS7vQKBLUn9Am1HHEu1ZfaLd3L6VgQ", Uncompressed: "1JcTeDgX1dVMwiW9DN61Gt1x4U7rbLrQAs", NestedSegwit: "3MSQThc4aqwoW9hV9bUKxHgRVA3Ahw8rs5", RedeemScript: "001427b17c7242b0041d1c4b242d23ed0b7308f9dd67", NativeSegwit: "bc1qy7chcujzkqzp68ztyskj8mgtwvy0nht84wzll4", XOnlyPubKey: "fe8d1eb1bcb3432b1db5833ff5f2226d9cb5e65cee430558c18ed3a3c86ce1af", Taproot: "bc1pg59xre6gvkwfpgnuwy4ar4d5cwxj0nya5erqwgcwc0m9z5crh0usyyvdts"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqepEKAcje", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5juRFNk1Vg", Compressed: "1LoNTxsB9bGXRqRBLqbKwNz9yzF39amkiC", Uncompressed: "14gXLdfh2sqCnuMvDEPMpAzgAMRt9iDPT9", NestedSegwit: "3Bkw3asZsDmmYSDLWRB25hJ1gAE7RYq43f", RedeemScript: "0014d92f712e7f3839a67aadb299dd512a55e02dc231", NativeSegwit: "bc1qmyhhztnl8qu6v74dk2va65f22hszms33gr5flr", XOnlyPubKey: "7a9375ad6167ad54aa74c6348cc54d344cc5dc9487d847049d5eabb0fa03c8fb", Taproot: "bc1psss4f58hcw3z0x0tvcl6jz7x0wv9at2pryz7s4694mea33p64rgsak6rgc"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqepQhCqTK", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5juv8WMAVD", Compressed: "19z7VNJxr5bfEiKLWot8B2rnMe2uMazX2F", Uncompressed: "1NPNXYwZXjHHUmNZ5yjGCRXycWbJSduFfz", NestedSegwit: "3FKvVdxWnXz82hb1y8dyjWJBUKQ2EzEB4z", RedeemScript: "0014628e2206aebd4e26e86b20224686e481f3cb68ab", NativeSegwit: "bc1qv28zyp4wh48zd6rtyq3ydphys8euk69tf0a2s8", XOnlyPubKey: "91de2f6bb67b11139f0e21203041bf080eacf59a33d99cd9f1929141bb0b4d0b", Taproot: "bc1pgqdhjzlku0amtdctcc4uk32ry9juxfw0652a67rt2uxtaf88r3eq808lg8"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqepToGvwg", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jvR1oB89X", Compressed: "1CBotfPmWKCTP7qEB63nB4D6cSbsBv8qXn", Uncompressed: "1FPQXEjTh5RAfnJeNAPyv5xfEwTNVbGTHn", NestedSegwit: "3EQM2SQ1CeEqc8AsgmBFt9zGzo5c9XrH5k", RedeemScript: "00147ab4af865bd1e410200261a785fe75dd82efb248", NativeSegwit: "bc1q0262lpjm68jpqgqzvxnctln4mkpwlvjgck03vu", XOnlyPubKey: "80c60ad0040f27dade5b4b06c408e56b2c50e9f56b9b8b425e555c2f86308b6f", Taproot: "bc1pxpc4va0acvf58v2qwmktuy6p86ch67s7kqru0yc5lyds8w9fwswqkfgsqr"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqepaaXPow", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jvuwJurkR", Compressed: "16B4ucETeu2MwKxm5WxxzbTLfBBrj1NRGZ", Uncompressed: "1GHKXCXPYhyJpPizgewyt22e67gcBben5Y", NestedSegwit: "3P31hww9q5DNXshxUwhKY9iHi6BFkpCp55", RedeemScript: "001438c00cc9fb54fb59f8be27df8542bb52fc3fa9b1", NativeSegwit: "bc1q8rqqej0m2na4n797yl0c2s4m2t7rl2d3duysr6", XOnlyPubKey: "b699a30e6e184cdfa88ac16c7d80bffd38e2e1fc705821ea69cd5fdf1691fff7", Taproot: "bc1pp8g4s20d8q2s5tq7ve3308gagrrph4kgp0u8xw27mlfc6r8ujdeses6nul"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqepktCsqr", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jwQnm1K9u", Compressed: "19RQEGMBaKNGGQNnftS1VeaHEQSo7iv9NC", Uncompressed: "1HskyAaSKozKoQ3YzretZsBzhcFGusoMiU", NestedSegwit: "3Dd42iG9SJkfkMctmGoZrZjNULHNqu6YQa", RedeemScript: "00145c5e6133bd2997b46cddf4397c0f6e296a67fe46", NativeSegwit: "bc1qt30xzvaa9xtmgmxa7suhcrmw994x0ljxcw63hl", XOnlyPubKey: "62d14dab4150bf497402fdc45a215e10dcb01c354959b10cfe31c7e9d87ff33d", Taproot: "bc1pl8tcejw96wtkuyav3jq0mch039gqgys895q5lfxg8t9edmjav4eqr73fq4"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeptR3dcN", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jwuij6Y6s", Compressed: "1NsUwAZiojCb9ufDiLoTiijBFy2UvAj4tp", Uncompressed: "1NtcfxvCYX76JR1cqp61ToAmtXzwYxatQb", NestedSegwit: "3ADKg6kbKYhqv3Lz2WyrDdVayXKoZudYfY", RedeemScript: "0014efe6c1f077d8ccbd9ea24215fa763689d6f43b08", NativeSegwit: "bc1qalnvrurhmrxtm84zgg2l5a3k38t0gwcgxnz3jc", XOnlyPubKey: "e0392cfa338aaf2f0b56c563e3e5e67a5d5fefe3388f85d90c899da20f0198f9", Taproot: "bc1pank8y2d86dxm36uvspfqgn6fr5xd9cn6rt27xhlle6ktz2mc035slvkun7"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeq1Uaa93", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jxQYssTJe", Compressed: "17tBvAGvVofr253SMc1H2Y4MALpvK8nqdV", Uncompressed: "1GtuZvcbKw1TsDkCQhDSvyvnfqnBiZHZqk", NestedSegwit: "3BBuFzsPNG6pnWwZt4haPYJ7dX7s5yVJhX", RedeemScript: "00144b7f14d8a9eff8edecbd88c72703410e0a0cf6f6", NativeSegwit: "bc1qfdl3fk9faluwmm9a3rrjwq6ppc9qeahkenxnsq", XOnlyPubKey: "605bdb019981718b986d0f07e834cb0d9deb8360ffb7f61df982345ef27a7479", Taproot: "bc1pfqh77e237u0u3rpzqxclx5phmcwj0suvlz9xex7cps0vafpyzrws4lesnm"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeq35A1TA", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jxuSxi51C", Compressed: "1LFEfrvJb2tsaDm5h94AxDK77X1dqfdnEu", Uncompressed: "1Gqw7w79Mb69tUFFRGpHHwtPkY6nEKint3", NestedSegwit: "3AHnpk99rMuMrHxyXefzvxQLSf3kq9arsk", RedeemScript: "0014d31b9fdfbf081dc435f13c5ed876bf8e11ec87fe", NativeSegwit: "bc1q6vdelhalpqwugd03830dsa4l3cg7epl7vnyn0p", XOnlyPubKey: "1be68a5a028f2601d0e80d468c344ba331d611b96c358b6032e8b4da0547fc11", Taproot: "bc1pd6lyx802xjz97enehh08jhgqkuyxqa8mqmy3yvxe58q05nf90cfsqh4dmg"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeqBRruvU", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jyQLhH2vj", Compressed: "1DDNFwqNX3m5kVWRg1ePAqoJngAavxBmnM", Uncompressed: "1DeRhsAca8qjL6Qk2E2d9L3wopD6MLWcDy", NestedSegwit: "3L4TDuTJ4hwbrTRxzbKf4vYef4LoR7roLo", RedeemScript: "001485f83ef78008fe0c6eba3012433f20740d9edc0a", NativeSegwit: "bc1qshuraauqprlqcm46xqfyx0eqwsxeahq2dvpswu", XOnlyPubKey: "1697ffa6fd9de627c077e3d2fe541084ce13300b0bec1146f95ae57f0d0bd6a5", Taproot: "bc1pnjr9m97np9lr2yqcnnt8j3875q626w2dcl2zv4k96dyy7tmgv2eqn0a877"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeqGkUfLX", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jyuExhvzf", Compressed: "12eqsJftjhW21MkA4AMqFntXUfMVkTzDvz", Uncompressed: "1yqGdk4DoEd1xiN3bhFdzf6ZGPxiymDvX", NestedSegwit: "31jgNEmWQvSzvJnhjaeTwJ6fPPA5h36eUb", RedeemScript: "001412204f0aa0fb80dab9f86c04a019502f6e2c8fc3", NativeSegwit: "bc1qzgsy7z4qlwqd4w0cdsz2qx2s9ahzer7rfqa426", XOnlyPubKey: "d30199d74fb5a22d47b6e054e2f378cedacffcb89904a61d75d0dbd407143e65", Taproot: "bc1ps9ysh7wfn2d5ljxm4y74rlrs5005ajdmwe27ad2ade9qyye26g9q3m53xd"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeqPropqU", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jzQ5GkZHm", Compressed: "1bD9vUMnriNiAE9yRRuxPs8cZ6FnF5mTz", Uncompressed: "1E4NhgXkqpZvnZPBZwynzNmTAcgKLiPUre", NestedSegwit: "37GdjvxCV8ZMffLzL1b74ADvRyqWwQVLxH", RedeemScript: "0014067849c7bea5b0e30850b6f7cf997daf56dde946", NativeSegwit: "bc1qqeuyn3a75kcwxzzskmmulxta4atdm62x567dqy", XOnlyPubKey: "6a245bf6dc698504c89a20cfded60853152b695336c28063b61c65cbd269e6b4", Taproot: "bc1p4larlwmm5gav6lk5uktk8cnn5hru3k9lj0c7x57uhj4aesn95uhse4elru"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeqVd2ZnE", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5jzu217cED", Compressed: "146WXvKwWuLH8xrFB7esbMHrN37qYYrWpN", Uncompressed: "1LJ5utuGegyKa6YbVTtxzZndFnSdHNzC5C", NestedSegwit: "3F1Ui43rq4PmMfPajF9sEWQpbRZ8xUKSEr", RedeemScript: "001421f31c327a2bfd4de3ee37e894186fc7c2a30171", NativeSegwit: "bc1qy8e3cvn69075mclwxl5fgxr0clp2xqt3lx43j7", XOnlyPubKey: "6d2b085e9e382ed10b69fc311a03f8641ccfff21574de0927513a49d9a688a00", Taproot: "bc1p75cewle4aycpqalrun65s3yrzeg5jt3d9m7yk6fuza7kakda8mesxuch5h"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeqaKfzBW", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k1Pqp4dvX", Compressed: "1HrRcmLhkirJeYcyKoYq5uJc2Zeb94E1vz", Uncompressed: "1KVPFr2XEwessL8J4zmqi5yFqD2BYVJ2Dk", NestedSegwit: "33hwyLCJHkEg8LHeJKM7Y5ZEEPPQdmyaZR", RedeemScript: "0014b8daf18f72e5e25876077c8123c44889e542dc06", NativeSegwit: "bc1qhrd0rrmjuh39sas80jqj83zg38j59hqxv636x4", XOnlyPubKey: "c44d12c7065d812e8acf28d7cbb19f9011ecd9e9fdf281b0e6a3b5e87d22e7db", Taproot: "bc1pzh9eg9xxxmz6g9vrjzkhdslkvc5u8vedy6xvmheuvqurf35m2a3qx9jxny"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeqhYRAUZ", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k1tmVrEfp", Compressed: "125wpFbHQrdRFuLvesEY96RavHM9T1yTFF", Uncompressed: "1NMiUoStJMYxfWw6APLRoMs24Fqsr9tmg7", NestedSegwit: "351jFGPiNY7h8cDuD211onkXi9JVzwLTAn", RedeemScript: "00140be78bef8259f0ca47854ef778318fa20528b832", NativeSegwit: "bc1qp0nchmuzt8cv53u9fmmhsvv05gzj3wpjr54k49", XOnlyPubKey: "55eb67d7b7238a70a7fa6f64d5dc3c826b31536da6eb344dc39a66f904f97968", Taproot: "bc1pj8wrhd32uxdv3pl6xjynnx3jntpgheksk83f0p7z4q77u55xdcdq7aenmc"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqequMbUK2", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k2PgeCBfz", Compressed: "1PdyW6CsrYbfcQsLNvp2BprLjvPTDYvBo3", Uncompressed: "12GQjWsXZ7rfRYCR4E5bHMg8AkoSxmPBox", NestedSegwit: "3HepdKJzEcc3AVfAaajjNHJui328EuujAo", RedeemScript: "0014f850e83018da26df5202116c901a56322a851b7a", NativeSegwit: "bc1qlpgwsvqcmgnd75szz9kfqxjkxg4g2xm6a8nzhz", XOnlyPubKey: "daed4f2be3a8bf278e70132fb0beb7522f570e144bf615c07e996d443dee8729", Taproot: "bc1p3azsnpk3n8jwrjuvq75zhwjmatseshysuy3wt044344cnlfexxmqkh4sed"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeqy1cgUY", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k2tYBSKZX", Compressed: "1HYXgyq17sNtGPVsrakdE2bfW1Hu1qpCq", Uncompressed: "14AJuXrdKFD8RzVtsF89FYVN4DSmb9xEPf", NestedSegwit: "37jHm89AV8fpCikCZACre635NVULq8fD6w", RedeemScript: "00140320f97dea6193d2a2901f2c8a157c94c2c0f6f2", NativeSegwit: "bc1qqvs0jl02vxfa9g5srukg59tujnpvpahjrtq66l", XOnlyPubKey: "6687cdb5b650d558f40cbdefc8e40997c03fe1b2abb840885e5cad81710c4c8a", Taproot: "bc1puwejyrtw7l2jhy0pvc0cx68emqfr8v6kddn4zu2l9gdq0mm2ch5qpcvkc8"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqer5piUXL", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k3PTBxkoC", Compressed: "1LnSLVs7CGEQBbY6w2J7kMAU8P48rA8zKQ", Uncompressed: "1N1sRyurQe7YouraPgh4rxV8JfARdv7zAH", NestedSegwit: "3CfpiAnpqrPXMPd7SRq8cRPioSHYZVByzK", RedeemScript: "0014d90241fb2e153278fcc53563c8036fccc4fec1e0", NativeSegwit: "bc1qmypyr7ewz5e83lx9x43usqm0enz0as0qpryq2q", XOnlyPubKey: "9248279b09b4d68dab21a9b066edda83263c3d84e09572e269ca0cd7f5453714", Taproot: "bc1p5ruyaft9jc8uqnf20ejfeaj0nart5yeaqt8pd0dlx89juse37twqn7kngf"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqerBWAT6z", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k3tLaLW5S", Compressed: "1LihsAYyVCsfuXXQaQfexNtHDCSWVzu2QQ", Uncompressed: "12gG3cNVexUjXCY3KqHi891Kiafsb8AaBy", NestedSegwit: "3DAeNjDBZwN75fGHP6EhLRqWe4nDyw1sMt", RedeemScript: "0014d84d8e7f7a029980cd7afd1f2473248456fa4caa", NativeSegwit: "bc1qmpxculm6q2vcpnt6l50jgueys3t05n92qcd2d8", XOnlyPubKey: "fe72c435413d33d48ac09c9161ba8b09683215439d62b7940502bda8b202e6ce", Taproot: "bc1pl5kw2xkrskxmt8stpgd5vv66tyqmhgtu5gtncqh4l80u02ml4axsmmtmhn"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqerKGMy47", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k4P9ccBa6", Compressed: "1BMNiGzCvpAMQGTn7NSFPTUtjwSNeB27nP", Uncompressed: "16oS9HkwfDmrCSGkaFe7KDQgkMFy5GXFoc", NestedSegwit: "3Chk2EYeaxUxHrs6MTo3YyF7FTb5unY67o", RedeemScript: "0014718bb377b2b9bbc97cd4bd68a9eff47b6948e732", NativeSegwit: "bc1qwx9mxaajhxaujlx5h452nml50d553eejh98ytj", XOnlyPubKey: "2fa2104d6b38d11b0230010559879124e42ab8dfeff5ff29dc9cdadd4ecacc3f", Taproot: "bc1pmdffvk0s5v597n4hckph6t293alkz8n4tk4h5mssfgpv7mvv0f4q45kn53"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqerQMuaXp", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k4t66iuSJ", Compressed: "1qapNkhu4ARLB2VvhjiRzoQUQdBedWx69", Uncompressed: "1E7rN6ZJ7g6mHYEZ643bJSFXSkwLw6Zzam", NestedSegwit: "3AGHuFNZMoXxB2MB12ZqFXr8e6xCH2pZRE", RedeemScript: "0014093031ecba8c8b0ab229d383b27992769d625eac", NativeSegwit: "bc1qpycrrm963j9s4v3f6wpmy7vjw6wkyh4vehtfxy", XOnlyPubKey: "421f5fc9a21065445c96fdb91c0c1e2f2431741c72713b4b99ddcb316f31e9fc", Taproot: "bc1pwh5nuzrshykryfezzfnswmgjekhw8mmp4a5jl7yvns6mmtrcpd3smav0xu"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqerai1D7C", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k5NxESy9s", Compressed: "1WZ1qft3wFmk8QP4dfUSqpyC4JEUiV1FR", Uncompressed: "18yhGBghaycjg3UhR2fiquffntYQpUGDE7", NestedSegwit: "32Vy8ACbxbQVeQ8yisXsYh1MqvQpo8z5LE", RedeemScript: "00140596c987822ff31fcb51d1451b172793cba0d66e", NativeSegwit: "bc1qqktvnpuz9le3lj6369z3k9e8j096p4nwn3qpkr", XOnlyPubKey: "352bbf4a4cdd12564f93fa332ce333301d9ad40271f8107181340aef25be59d5", Taproot: "bc1pssmuqnp7u3eavjvqph0j2f36ar4ejtu7yreykq9vuw528sskwcnqh6t7hx"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqerf2U3AK", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k5ssdRixM", Compressed: "12yHuvGnsJbAEgvqajjPdCve91Aa294AHt", Uncompressed: "17QPbFArTP6M6QRg2ZE18D3fvzZYxnRUSb", NestedSegwit: "36D2Urj3uePwVmyGCyhrMmddneGonWfiYp", RedeemScript: "0014159d8992abc69e3fba7817c826dbacb606d68eb4", NativeSegwit: "bc1qzkwcny4tc60rlwnczlyzdkavkcrddr45zcugac", XOnlyPubKey: "4ce119c96e2fa357200b559b2f7dd5a5f02d5290aff74b03f3e471b273211c97", Taproot: "bc1pzentd5v05wwrm968060lvanzsrcn7j24vqzers4gxz8k59g3r0eqezt9ur"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqeromqrGX", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k6NiGCEBX", Compressed: "1EmghU6CBBfw1wyJqguXeWtjUhW3kmzwbU", Uncompressed: "1Fp1zhPoKnKfm8MLYkQZ33GZRbJpE9inpB", NestedSegwit: "398qbTek5dXMtoS9aFQGCu7d22DdEqKD57", RedeemScript: "0014970d1353066f4986d361522d1136f13bc4890c67", NativeSegwit: "bc1qjux3x5cxdaycd5mp2gk3zdh380zgjrr86dvwyt", XOnlyPubKey: "2b4ea0a797a443d293ef5cff444f4979f06acfebd7e86d277475656138385b6c", Taproot: "bc1p9gxnc4z646nmgd4ka9ny9a0nv45fx8y4ny6e4d74tfvszz9u65nqscx4x3"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqertvRkec", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k6sahbZue", Compressed: "19CEpYsRwMirXiFFSM7daVxtwALrERMaWf", Uncompressed: "12TqhXBmGoaaJoudt1MdysYb2JqGWUGoL1", NestedSegwit: "3GnFjY9LfXDx1qgkf7odiPtA9a9tpicuqj", RedeemScript: "001459e11e38c00db68386e9da9700ba89c817439d96", NativeSegwit: "bc1qt8s3uwxqpkmg8phfm2tspw5feqt588vkwrnfwr", XOnlyPubKey: "5601570cb47f238d2b0286db4a990fa0f3ba28d1a319f5e7cf55c2a2444da7cc", Taproot: "bc1p7uhh0z3h4yuf4l9ecgzs692xctkm777x5due78x6alnrkfaz84tslrerz2"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqes1UC6J5", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k7NVCBwG4", Compressed: "13wyRkVE4XGmNW3g2xgA2SGpKysDtjy1Ka", Uncompressed: "14X7DSjXSQBqvFVshZuNwVW6GZyNp79AjF", NestedSegwit: "3FKqTVHQktA3FHAb99io57sdZp6gYG1GBz", RedeemScript: "00142055d0efbcb3569e00a14e93eabd2cc2933a2096", NativeSegwit: "bc1qyp2apmaukdtfuq9pf6f740fvc2fn5gykcpkzvq", XOnlyPubKey: "defdea4cdb677750a420fee807eacf21eb9898ae79b9768766e4faa04a2d4a34", Taproot: "bc1pzc0r8r2gs5atzpqvnx3j0h7e2sqg3uwe5fchf3vcxcmd7m4ccxgqt4z4vy"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqes6XLxKo", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k7sPfyLjz", Compressed: "1AaoXdKGqj5bHoFAUSLwfv5C2CkAi5RjFE", Uncompressed: "1ADGZZSKRqz3ydkn714Qzw1FJSbUZZGEr1", NestedSegwit: "3KjFK6Gsuj72wxByWrQcuPh9qiFSfQKuZ3", RedeemScript: "0014691db2cd18cd33f65cfb4cbbde51e7a94a94f549", NativeSegwit: "bc1qdywm9ngce5elvh8mfjaau508499ffa2fr8h4sg", XOnlyPubKey: "e60fce93b59e9ec53011aabc21c23e97b2a31369b87a5ae9c44ee89e2a6dec0a", Taproot: "bc1paulgjgmqs2g6g0770p2x506n4fswfn93xh2lggjxeemha2agfj2sr266xu"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqesAbJPSv", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k8NBPWRfP", Compressed: "1EsZ8f9hGrd9cH35gWLuKbP3J793rArBSt", Uncompressed: "1E1oVu22jUEvmQTFDy9bTgabSfmns6fQFY", NestedSegwit: "3GZTYjYSoy9tBYmqWNB2Y89Mr5XJh2ew2S", RedeemScript: "0014982941789d775bfcb3b844c27042fd73595aaf5b", NativeSegwit: "bc1qnq55z7yawadlevacgnp8qshawdv44t6m9xwau7", XOnlyPubKey: "d7924d4f7d43ea965a465ae3095ff41131e5946f3c85f79e44adbcf8e27e080e", Taproot: "bc1pya0kmsgqk4cy8fz8dws52zzc3cy5zxxjr99a8xyyja0xs5w7ugzqakkk4k"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqesHpSgWj", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k8s67YduF", Compressed: "1Lj2EgsaunRNwsyEK32ebjofbu1tPxxtEy", Uncompressed: "1KWhn5gquQvXyXp9BMgJ6HYfNwpHZDmJ5c", NestedSegwit: "3Lk1ABTgBABRdbfJCiN4D6rqrWsEcqPaYJ", RedeemScript: "0014d85ce45cb96f4fc58e6fc6485860963e0c8bd521", NativeSegwit: "bc1qmpwwgh9eda8utrn0cey9scyk8cxgh4fpynkrdl", XOnlyPubKey: "499fdf9e895e719cfd64e67f07d38e3226aa7b63678949e6e49b241a60e823e4", Taproot: "bc1pkadv4tfenky2srz5yr60ek6ty2pz42k0nlxddkhhc9ykl9harp9qu6y07x"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqesTFJEbX", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k9MyyVRKz", Compressed: "12sQJfPVt5YuAbmDCWnym5tNDfshtpBXhB", Uncompressed: "122Vo9PeKd4j8zSGBeQHdmks6GnkpycXNz", NestedSegwit: "3BqUvUttyp3sxNpzjSYJzEyYiEGMiDxoVa", RedeemScript: "0014148060a401bd6cca27f2cc604d21ac9a5e80ea9b", NativeSegwit: "bc1qzjqxpfqph4kv5flje3sy6gdvnf0gp65mjt0vxd", XOnlyPubKey: "f28773c2d975288bc7d1d205c3748651b075fbc6610e58cddeeddf8f19405aa8", Taproot: "bc1pgk6qmca8k3yj4kerl792kpa46uhs20xtc99dwrm35q7jh5gvrlss5ne3vs"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqesYcYp9K", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5k9ruPnxYJ", Compressed: "1FyqVysQjVwyQatuoop3ByZYPecUhj6bnr", Uncompressed: "1PMB9Etp3xaDKxpmofy1MmjJF1kvCtH8UA", NestedSegwit: "37naWwqnN5nw5re59jSoHm8NtZZsAG4NTy", RedeemScript: "0014a4518a51fe4057534977c68b373b7aad7d8cf7b1", NativeSegwit: "bc1q53gc5507gpt4xjthc69nwwm6447ceaa3psktsp", XOnlyPubKey: "d01115d548e7561b15c38f004d734633687cf4419620095bc5b0f47070afe85a", Taproot: "bc1pwd5vfca5jmgxjnd9mdz4mkzn6nyag2kqrqlmuj78ykgvy6vdc60qtmhctz"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqesi68P9B", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kAMo85wE8", Compressed: "1DNv5wVZKZvFp5gktKtN83ZwEfcQt8oKac", Uncompressed: "1zrbUnLczbHkA6pzXuZDD6jNsoKMqGBcy", NestedSegwit: "3PbrvMUfuZuCUrxgQ6pQXcoWHSZALECduD", RedeemScript: "001487c68ed054beb938259f10591e85bc71c820bd82", NativeSegwit: "bc1qslrga5z5h6unsfvlzpv3apduw8yzp0vz8r3shx", XOnlyPubKey: "774ae7f858a9411e5ef4246b70c65aac5649980be5c17891bbec17895da008cb", Taproot: "bc1pxy2wupspts5wl3ctseak4g7j42h6mumaqlq6zzrn6qesraqlqz7qk730p9"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqesmCC6YY", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kArcmBQST", Compressed: "1Aea8LKoEEWpPqTqaSwRYfksmUScVqV1F6", Uncompressed: "18PUeum1Su423DmV2jEGdSd3ewiPfsZZ7z", NestedSegwit: "369QrLHMbLSywZ3g1Wyuz1HFyF9XwSkfLf", RedeemScript: "001469d42cd99051e5dd34409ccea5a13e248b0438fe", NativeSegwit: "bc1qd82zekvs28ja6dzqnn82tgf7yj9sgw876x6ksq", XOnlyPubKey: "a0434d9e47f3c86235477c7b1ae6ae5d3442d49b1943c2b752a68e2a47e247c7", Taproot: "bc1p5mmme8n7pqk4x55sky33h3xxu0hp9tnuszt78szmhv8su25a4y3smy8tg3"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqestnHCQU", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kBMZjZv5z", Compressed: "1Nk4wGvaSinFVdrnMfEexLDnBZvWPY393C", Uncompressed: "1GLiZZVt326aA8JHG2dEJHC591DXDQNKTs", NestedSegwit: "32BBGmK1zF88mQLC93DBZTuD4x8nsEZaDm", RedeemScript: "0014ee7fd02fd72abd60ffcf29a23550232282a8c05b", NativeSegwit: "bc1qaelaqt7h927kpl709x3r25pry2p23szmd0jj29", XOnlyPubKey: "acd484e2f0c7f65309ad178a9f559abde09796974c57e714c35f110dfc27ccbe", Taproot: "bc1pan4szzggtg9rudp5lt4tyr5aprjmflwg2wxy8zarue6gxjw47f0s7sdrjj"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqet3sujS6", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kBrPRP21S", Compressed: "1A81LWBrirUNAKpUVFS37xWT4GAMYU5qgD", Uncompressed: "1MFyofP8SVtsEYDHQbZg7XJgfDeSP4ysPm", NestedSegwit: "3EsYpLnq57H9bxEpbZx6anKVCjZ6KyDTtR", RedeemScript: "0014640c5311682b7937bdd8873f5e6a099743d6fe56", NativeSegwit: "bc1qvsx9xytg9dun00wcsul4u6sfjapadljkzalyju", XOnlyPubKey: "2f01e5e15cca351daff3843fb70f3c2f0a1bdd05e5af888a67784ef3e10a2a01", Taproot: "bc1p5ju3f0m0dz3y4hgynde804krzz2grxr8chytzgew4fxmk5lunzlqwe8xsx"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqet8uM8zj", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kCMMDCHBN", Compressed: "1BJYFk5827oeYipArjTvLL7JdR4ivCGFYj", Uncompressed: "1XunvtCGpmb7uw9qxWwaZFfHNFdUmuMVG", NestedSegwit: "3D7LDVeeQ53eQM82McjifQdkEUcRJ5gura", RedeemScript: "001471026b3acffa88fb89c0731bdf125ad1e8755778", NativeSegwit: "bc1qwypxkwk0l2y0hzwqwvda7yj6685824mc4vpmz4", XOnlyPubKey: "5cbdf0646e5db4eaa398f365f2ea7a0e3d419b7e0330e39ce92bddedcac4f9bc", Taproot: "bc1pr896td3mmjl5vc57mutumgfmeet8hyzy9w6zr6hg5vhrtppevlpsp3x3nm"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetEoeLmv", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kCr8xK7La", Compressed: "1Lvxa3uJyPyRLbrNpGx761aSDWrJ77aTNm", Uncompressed: "1J2zofmGpMUSaNGdTZEhMRYXdWsBQFMpS", NestedSegwit: "3HDoQLvg6XQJg28nQHTajhAyewCsHMG4Kf", RedeemScript: "0014da9ed2bebfaadd0d6d5982f1624f161c29fab6ec", NativeSegwit: "bc1qm20d904l4tws6m2estckynckrs5l4dhvq9ntk7", XOnlyPubKey: "fff97bd5755eeea420453a14355235d382f6472f8568a18b2f057a1460297556", Taproot: "bc1p4rsld9ryjhte00drc0r23r8ngd63xrzh5s4fvmy6q5yt70xzlsdqcuvtzv"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetNQLySX", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kDM7Fgn25", Compressed: "1JSVicNeasrtuiDpb6r4J5fWxjfdU7ZyWT", Uncompressed: "1LWBSfTeaLRNS1vyGSKy2BVW2nd6W9sk8Q", NestedSegwit: "36x45gCwYCZwUnXRdgX7fzLBRdppyFN9AN", RedeemScript: "0014bf4c826bc784b86a8b98fd001fda9351b9681eb4", NativeSegwit: "bc1qhaxgy678sjux4zucl5qplk5n2xuks845cqwyh2", XOnlyPubKey: "2f8bde4d1a07209355b4a7250a5c5128e88b84bddc619ab7cba8d569b240efe4", Taproot: "bc1paecncecu260mkwvsr63lw5v4s496v9gfn2en56hv4f0d2w2j97fsmfm28s"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetVTGEAr", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kDqyBgPYj", Compressed: "1FjMR9gvnmZ3JYMxBbyc3aZK717b5txJoC", Uncompressed: "1F3zbGb5JLBnmCAAYjCCv35zkggrXfi8LR", NestedSegwit: "3KcMnnh1SYmc75xYqfSypLM7XprDyCKBWX", RedeemScript: "0014a194462b709e46d44ec0c6f960ea3ed8ca683645", NativeSegwit: "bc1q5x2yv2msnerdgnkqcmukp637mr9xsdj9tv76fm", XOnlyPubKey: "e493dbf1c10d80f3581e4904930b1404cc6c13900ee0758474fa94abe8c4cd13", Taproot: "bc1pjvtc2mkj9vmfneuj7w9dsqle70a040ms5tyfswhhz4vjyskznj5ql45vlj"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetbh69Dr", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kELprCx3Q", Compressed: "1HjFHBmhUQkKntPPeWmiLiNGewRAMQWNYs", Uncompressed: "15K4QVHD5T1KvW4it56qNuGJoTGMpUaFMj", NestedSegwit: "3BMQ4Vm19qUNC9zU5o7Y8e47c6GMnuxuyZ", RedeemScript: "0014b77f670a186c3d0c4bd0f7bdd07982edaa3662c5", NativeSegwit: "bc1qkalkwzscds7scj7s777aq7vzak4rvck9zje9kl", XOnlyPubKey: "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9", Taproot: "bc1pgxxyvcmdncdxs06cudd5yvmwwahaesaj6n3eu7st7x4sw9hrchaqjy33gs"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetd9ZKJ4", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kEqeonMfk", Compressed: "1NjSB7UL4MtdjmPbTUfaHne9R5C2YGxUSA", Uncompressed: "1Knh2eFMtzMEtmvGHW14ELG8F9Ny6jV4s3", NestedSegwit: "3QKUJNy5PAGrZeSvnReuV2aqLp9uEYFrPJ", RedeemScript: "0014ee6120575db94b9efdd9c7f56212b23e7ff6e56f", NativeSegwit: "bc1qaesjq46ah99ealwecl6kyy4j8elldet0zuk529", XOnlyPubKey: "c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5", Taproot: "bc1pet7ep3czdu9k4wvdlz2fp5p8x2yp7t6ttyqg2c6cmh0lgeuu9lasmp9hsg"},
+				{Private: "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetqj84qw", PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kFLaHLuZ9", Compressed: "1GrLCmVQXoyJXaPJQdqssNqwxvha1eUo2E", Uncompressed: "1JPbzbsAx1HyaDQoLMapWGoqf9pD5uha5m", NestedSegwit: "38Kw57SDszoUEikRwJNBpypPSdpbAhToeD", RedeemScript: "0014adde4c73c7b9cee17da6c7b3e2b2eea1a0dcbe67", NativeSegwit: "bc1q4h0ycu78h88wzldxc7e79vhw5xsde0n8jk4wl5", XOnlyPubKey: "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", Taproot: "bc1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5sspknck9"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKeys, err := GenerateBitcoinKeys(tt.args.pageNumber, tt.args.keysPerpage, &chaincfg.MainNetParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("Expected:")
+				for _, expectedKey := range tt.wantKeys {
+					t.Errorf("%#v", expectedKey)
+				}
+
+				t.Errorf("Actual:")
+				for _, actualKey := range gotKeys {
+					t.Errorf("%#v", actualKey)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateBitcoinKeys_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		pageNumber string
+		wantErr    error
+	}{
+		{"It rejects a page that is not a number", "abc", ErrInvalidPage},
+		{"It rejects page zero", "0", ErrInvalidPage},
+		{"It rejects a negative page", "-1", ErrInvalidPage},
+		{"It rejects a page after the last page", "904625697166532776746648320380374280100293470930272690489102837043110636676", ErrPageOutOfRange},
+		{"It rejects a page far after the last page", "904625697166532776746648320380374280100293470930272690489102837043110636999", ErrPageOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GenerateBitcoinKeys(tt.pageNumber, 128, &chaincfg.MainNetParams); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+		})
+	}
+}
+
+func TestFindBtcWifPage(t *testing.T) {
+	type args struct {
+		wifString   string
+		keysPerPage int
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		wantPage string
+	}{
+		{
+			"It can find the page that a random WIF is on",
+			args{"5KQkycVaH2urSTz9CQ4fGdWz3a5n9TFKLDwxzREv8tBtcXYW9Ua", 128},
+			"741968862012117112677494014490987968047399326671284349197372731288562495168",
+		},
+		{
+			"It can find a WIF on the first page",
+			args{"5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf", 128},
+			"1",
+		},
+		{
+			"It can find a WIF on the last page",
+			args{"5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetqj84qw", 128},
+			"904625697166532776746648320380374280100293470930272690489102837043110636675",
+		},
+		{
+			"It can find the last WIF on the first page",
+			args{"5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreR42AY81", 128},
+			"1",
+		},
+		{
+			"It can find the first WIF on the second page",
+			args{"5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreRBLscCA", 128},
+			"2",
+		},
+		{
+			"It can find a compressed WIF on the first page",
+			args{"KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", 128},
+			"1",
+		},
+		{
+			"It can find a compressed WIF on the last page",
+			args{"L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kFLaHLuZ9", 128},
+			"904625697166532776746648320380374280100293470930272690489102837043110636675",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, err := FindBtcWifPage(tt.args.wifString, tt.args.keysPerPage, &chaincfg.MainNetParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotPage, tt.wantPage) {
+				t.Errorf("Expected: %v", tt.wantPage)
+				t.Errorf("Actual: %v", gotPage)
+			}
+		})
+	}
+}
+
+func TestFindBtcWifPage_errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		wifString string
+		wantErr   error
+	}{
+		{"It rejects a malformed WIF", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDx", ErrMalformedKey},
+		{"It rejects a WIF for another network", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", ErrMalformedKey},
+		{"It rejects the zero key", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAbuatmU", ErrKeyOutOfRange},
+		{"It rejects the curve order", "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetwr388P", ErrKeyOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FindBtcWifPage(tt.wifString, 128, &chaincfg.MainNetParams); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+		})
+	}
+}
+
+func TestGenerateBitcoinKeys_bip86(t *testing.T) {
+	// BIP86 test vector for m/86'/0'/0'/0/0 of the "abandon ... about" mnemonic
+	seed, _ := new(big.Int).SetString("41f41d69260df4cf277826a9b65a3717e4eeddbeedf637f212ca096576479361", 16)
+
+	keys, err := GenerateBitcoinKeys(seed.String(), 1, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(keys) != 1 {
+		t.Fatalf("Expected 1 key, got %d", len(keys))
+	}
+
+	wantXOnly := "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115"
+	wantTaproot := "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"
+
+	if keys[0].XOnlyPubKey != wantXOnly {
+		t.Errorf("Expected x-only public key: %v", wantXOnly)
+		t.Errorf("Actual:                     %v", keys[0].XOnlyPubKey)
+	}
+
+	if keys[0].Taproot != wantTaproot {
+		t.Errorf("Expected address: %v", wantTaproot)
+		t.Errorf("Actual:           %v", keys[0].Taproot)
+	}
+}
+
+func Test_encodeTaprootAddress(t *testing.T) {
+	// BIP350 test vector, a witness version 1 program holding the x coordinate of the generator point
+	program, _ := hex.DecodeString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+
+	want := "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"
+
+	if got, _ := encodeTaprootAddress("bc", program); got != want {
+		t.Errorf("Expected: %v", want)
+		t.Errorf("Actual:   %v", got)
+	}
+}
+
+func TestGenerateBitcoinKeys_networks(t *testing.T) {
+	tests := []struct {
+		network string
+		wantKey BitcoinKey
+	}{
+		{
+			"testnet",
+			BitcoinKey{Private: "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx", PrivateCompressed: "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", Compressed: "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", Uncompressed: "mtoKs9V381UAhUia3d7Vb9GNak8Qvmcsme", NestedSegwit: "2NAUYAHhujozruyzpsFRP63mbrdaU5wnEpN", RedeemScript: "0014751e76e8199196d454941c45d1b3a323f1433bd6", NativeSegwit: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", XOnlyPubKey: "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", Taproot: "tb1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5ssk79hv2"},
+		},
+		{
+			"signet",
+			BitcoinKey{Private: "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx", PrivateCompressed: "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", Compressed: "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", Uncompressed: "mtoKs9V381UAhUia3d7Vb9GNak8Qvmcsme", NestedSegwit: "2NAUYAHhujozruyzpsFRP63mbrdaU5wnEpN", RedeemScript: "0014751e76e8199196d454941c45d1b3a323f1433bd6", NativeSegwit: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", XOnlyPubKey: "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", Taproot: "tb1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5ssk79hv2"},
+		},
+		{
+			"regtest",
+			BitcoinKey{Private: "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx", PrivateCompressed: "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", Compressed: "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", Uncompressed: "mtoKs9V381UAhUia3d7Vb9GNak8Qvmcsme", NestedSegwit: "2NAUYAHhujozruyzpsFRP63mbrdaU5wnEpN", RedeemScript: "0014751e76e8199196d454941c45d1b3a323f1433bd6", NativeSegwit: "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", XOnlyPubKey: "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", Taproot: "bcrt1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5ssm803es"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.network, func(t *testing.T) {
+			params, err := BitcoinNetwork(tt.network)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotKeys, err := GenerateBitcoinKeys("1", 1, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotKeys, []BitcoinKey{tt.wantKey}) {
+				t.Errorf("Expected: %#v", tt.wantKey)
+				t.Errorf("Actual:   %#v", gotKeys)
+			}
+		})
+	}
+}
+
+func TestFindBtcWifPage_networks(t *testing.T) {
+	testnet, _ := BitcoinNetwork("testnet")
+
+	if gotPage, _ := FindBtcWifPage("cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", 128, testnet); gotPage != "1" {
+		t.Errorf("Expected: %v", "1")
+		t.Errorf("Actual:   %v", gotPage)
+	}
+
+	if _, err := FindBtcWifPage("KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", 128, testnet); err == nil {
+		t.Errorf("Expected a network mismatch error")
+	}
+
+	if _, err := BitcoinNetwork("dogecoin"); err == nil {
+		t.Errorf("Expected an error for an unknown network")
+	}
+}
+
+func TestFindBtcWifPage_keysPerPage(t *testing.T) {
+	// every key that is generated on a page is found on that page, for any page size
+	for _, keysPerPage := range []int{1, 7, 50, 128} {
+		keyspace, _ := BitcoinKeyspace(keysPerPage)
+
+		for _, pageNumber := range []string{"1", "2", keyspace.LastPage().String()} {
+			bitcoinKeys, err := GenerateBitcoinKeys(pageNumber, keysPerPage, &chaincfg.MainNetParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, key := range bitcoinKeys {
+				for _, wif := range []string{key.Private, key.PrivateCompressed} {
+					if gotPage, _ := FindBtcWifPage(wif, keysPerPage, &chaincfg.MainNetParams); gotPage != pageNumber {
+						t.Errorf("Expected %v to be on page %v of %d keys, got %v", wif, pageNumber, keysPerPage, gotPage)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLocateBtcWif(t *testing.T) {
+	tests := []struct {
+		name        string
+		wif         string
+		keysPerPage int
+		wantPage    string
+		wantIndex   int
+		wantSeed    string
+	}{
+		{"It can locate the first key", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf", 128, "1", 0, "1"},
+		{"It can locate the last key of a page", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreR42AY81", 128, "1", 127, "128"},
+		{"It can locate the first key of a page", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreRBLscCA", 128, "2", 0, "129"},
+		{"It can locate a compressed WIF", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU74sHUHy8S", 2, "2", 0, "3"},
+		{"It can locate the last key", "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kFLaHLuZ9", 128, "904625697166532776746648320380374280100293470930272690489102837043110636675", 63, "115792089237316195423570985008687907852837564279074904382605163141518161494336"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location, err := LocateBtcWif(tt.wif, tt.keysPerPage, &chaincfg.MainNetParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if location.Page.String() != tt.wantPage || location.Index != tt.wantIndex || location.Seed.String() != tt.wantSeed {
+				t.Errorf("Expected: page %v index %d seed %v", tt.wantPage, tt.wantIndex, tt.wantSeed)
+				t.Errorf("Actual:   page %v index %d seed %v", location.Page, location.Index, location.Seed)
+			}
+		})
+	}
+}
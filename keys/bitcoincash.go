@@ -0,0 +1,123 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// BitcoinCashKey is one row of a Bitcoin Cash page. Bitcoin Cash has the keys and the legacy
+// addresses of Bitcoin, wallets display the CashAddr encoding of the addresses.
+type BitcoinCashKey struct {
+	// Private is the uncompressed WIF, the same as on Bitcoin pages
+	Private string
+	// Compressed is the CashAddr P2PKH address of the compressed public key (bitcoincash:q… on mainnet)
+	Compressed string
+	// Uncompressed is the CashAddr P2PKH address of the uncompressed public key
+	Uncompressed string
+	// LegacyCompressed is the base58 P2PKH address of the compressed public key
+	LegacyCompressed string
+	// LegacyUncompressed is the base58 P2PKH address of the uncompressed public key
+	LegacyUncompressed string
+	// PrivateCompressed is the compressed WIF, the same as on Bitcoin pages
+	PrivateCompressed string
+}
+
+// Values returns the fields of the key in the order of the columns of BitcoinCash
+func (k BitcoinCashKey) Values() []string {
+	return []string{k.Private, k.Compressed, k.Uncompressed, k.LegacyCompressed, k.LegacyUncompressed, k.PrivateCompressed}
+}
+
+// bitcoinCashNetwork is a network of Bitcoin Cash, the parameters of its legacy encodings and the
+// prefix of its CashAddr addresses
+type bitcoinCashNetwork struct {
+	altNetwork
+	prefix string
+}
+
+var bitcoinCashNetworks = []bitcoinCashNetwork{
+	{altNetwork{"mainnet", altParams("bitcoincash-mainnet", 0x00, 0x05, 0x80, "")}, "bitcoincash"},
+	{altNetwork{"testnet", altParams("bitcoincash-testnet", 0x6f, 0xc4, 0xef, "")}, "bchtest"},
+	{altNetwork{"regtest", altParams("bitcoincash-regtest", 0x6f, 0xc4, 0xef, "")}, "bchreg"},
+}
+
+// BitcoinCash is the coin of Bitcoin Cash pages, they list the seeds of Bitcoin pages
+var BitcoinCash Coin = bitcoinCashCoin{network: bitcoinCashNetworks[0]}
+
+type bitcoinCashCoin struct {
+	network bitcoinCashNetwork
+}
+
+func (bitcoinCashCoin) Name() string {
+	return "bch"
+}
+
+func (bitcoinCashCoin) Title() string {
+	return "Bitcoin Cash"
+}
+
+func (bitcoinCashCoin) Networks() []string {
+	var names []string
+	for _, network := range bitcoinCashNetworks {
+		names = append(names, network.name)
+	}
+
+	return names
+}
+
+func (c bitcoinCashCoin) Network(name string) (Coin, error) {
+	if name == "" {
+		return BitcoinCash, nil
+	}
+
+	for _, network := range bitcoinCashNetworks {
+		if network.name == name {
+			return bitcoinCashCoin{network: network}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown %s network %q, expected %s", strings.ToLower(c.Title()), name, orList(c.Networks()))
+}
+
+func (bitcoinCashCoin) Keyspace(keysPerPage int) (*Keyspace, error) {
+	return BitcoinKeyspace(keysPerPage)
+}
+
+func (bitcoinCashCoin) Columns() []string {
+	return []string{"private", "compressed", "uncompressed", "legacyCompressed", "legacyUncompressed", "privateCompressed"}
+}
+
+func (c bitcoinCashCoin) Row(seed *big.Int) []string {
+	return bitcoinCashKeyFromSeed(seed, c.network).Values()
+}
+
+func (c bitcoinCashCoin) ParsePrivateKey(input string) (PrivateKey, error) {
+	return ParseNetworkPrivateKey(input, c.network.params)
+}
+
+func bitcoinCashKeyFromSeed(seed *big.Int, network bitcoinCashNetwork) BitcoinCashKey {
+	// The network has no bech32 prefix, so the Bitcoin key only has the WIFs and the legacy addresses
+	bitcoinKey := bitcoinKeyFromSeed(seed, network.params)
+
+	key := BitcoinCashKey{
+		Private:            bitcoinKey.Private,
+		PrivateCompressed:  bitcoinKey.PrivateCompressed,
+		LegacyCompressed:   bitcoinKey.Compressed,
+		LegacyUncompressed: bitcoinKey.Uncompressed,
+	}
+
+	if bitcoinKey.Compressed == "" {
+		return key
+	}
+
+	// A CashAddr address encodes the same hash160 as the legacy address
+	compressedHash, _, _ := base58.CheckDecode(bitcoinKey.Compressed)
+	uncompressedHash, _, _ := base58.CheckDecode(bitcoinKey.Uncompressed)
+
+	key.Compressed, _ = encodeCashAddr(network.prefix, cashAddrP2PKH, compressedHash)
+	key.Uncompressed, _ = encodeCashAddr(network.prefix, cashAddrP2PKH, uncompressedHash)
+
+	return key
+}
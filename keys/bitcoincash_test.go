@@ -0,0 +1,130 @@
+package keys
+
+import (
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// Test_encodeCashAddr checks the test vectors of the CashAddr specification
+func Test_encodeCashAddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		addrType byte
+		hash     string
+		want     string
+	}{
+		{"It can encode the P2PKH address of 1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", "bitcoincash", cashAddrP2PKH, "76a04053bda0a88bda5177b86a15c3b29f559873", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{"It can encode the P2PKH address of 1KXrWXciRDZUpQwQmuM1DbwsKDLYAYsVLR", "bitcoincash", cashAddrP2PKH, "cb481232299cd5743151ac4b2d63ae198e7bb0a9", "bitcoincash:qr95sy3j9xwd2ap32xkykttr4cvcu7as4y0qverfuy"},
+		{"It can encode the P2PKH address of 16w1D5WRVKJuZUsSRzdLp9w3YGcgoxDXb", "bitcoincash", cashAddrP2PKH, "011f28e473c95f4013d7d53ec5fbc3b42df8ed10", "bitcoincash:qqq3728yw0y47sqn6l2na30mcw6zm78dzqre909m2r"},
+		{"It can encode the P2SH address of 3CWFddi6m4ndiGyKqzYvsFYagqDLPVMTzC", "bitcoincash", 1, "76a04053bda0a88bda5177b86a15c3b29f559873", "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq"},
+		{"It can encode a P2PKH address", "bitcoincash", cashAddrP2PKH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9", "bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2"},
+		{"It can encode a testnet P2SH address", "bchtest", 1, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9", "bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t"},
+		{"It can encode another prefix", "pref", 1, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9", "pref:pr6m7j9njldwwzlg9v7v53unlr4jkmx6ey65nvtks5"},
+		{"It can encode another type", "prefix", 15, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9", "prefix:0r6m7j9njldwwzlg9v7v53unlr4jkmx6ey3qnjwsrf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, _ := hex.DecodeString(tt.hash)
+
+			got, err := encodeCashAddr(tt.prefix, tt.addrType, hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Expected: %v", tt.want)
+				t.Errorf("Actual:   %v", got)
+			}
+		})
+	}
+}
+
+func TestBitcoinCash_Row(t *testing.T) {
+	tests := []struct {
+		name    string
+		network string
+		seed    *big.Int
+		wantKey BitcoinCashKey
+	}{
+		{
+			"It can generate Bitcoin Cash keys",
+			"mainnet",
+			big.NewInt(1),
+			BitcoinCashKey{
+				Private:            "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf",
+				PrivateCompressed:  "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn",
+				Compressed:         "bitcoincash:qp63uahgrxged4z5jswyt5dn5v3lzsem6cy4spdc2h",
+				Uncompressed:       "bitcoincash:qzgmyjle755g2v5kptrg02asx5f8k8fg55zdx7hd4l",
+				LegacyCompressed:   "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+				LegacyUncompressed: "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm",
+			},
+		},
+		{
+			"It can generate Bitcoin Cash testnet keys",
+			"testnet",
+			big.NewInt(1),
+			BitcoinCashKey{
+				Private:            "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx",
+				PrivateCompressed:  "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA",
+				Compressed:         "bchtest:qp63uahgrxged4z5jswyt5dn5v3lzsem6cq85x00dt",
+				Uncompressed:       "bchtest:qzgmyjle755g2v5kptrg02asx5f8k8fg55xlze46jr",
+				LegacyCompressed:   "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r",
+				LegacyUncompressed: "mtoKs9V381UAhUia3d7Vb9GNak8Qvmcsme",
+			},
+		},
+		{
+			"It can generate Bitcoin Cash regtest keys",
+			"regtest",
+			big.NewInt(1),
+			BitcoinCashKey{
+				Private:            "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx",
+				PrivateCompressed:  "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA",
+				Compressed:         "bchreg:qp63uahgrxged4z5jswyt5dn5v3lzsem6c6mz8vuwd",
+				Uncompressed:       "bchreg:qzgmyjle755g2v5kptrg02asx5f8k8fg55ur5ckf39",
+				LegacyCompressed:   "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r",
+				LegacyUncompressed: "mtoKs9V381UAhUia3d7Vb9GNak8Qvmcsme",
+			},
+		},
+		{
+			"It can generate the keys of an invalid seed",
+			"mainnet",
+			curveOrder,
+			BitcoinCashKey{
+				Private:           "5Km2kuu7vtFDPpxywn4u3NLpbr5jKpTB3jsuDU2KYEqetwr388P",
+				PrivateCompressed: "L5oLkpV3aqBjhki6LmvChTCV6odsp4SXM6FfU2Gppt5kFqRzExJJ",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coin, err := BitcoinCash.Network(tt.network)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := coin.Row(tt.seed); !reflect.DeepEqual(got, tt.wantKey.Values()) {
+				t.Errorf("Expected: %v", tt.wantKey.Values())
+				t.Errorf("Actual:   %v", got)
+			}
+		})
+	}
+}
+
+// TestBitcoinCash_sameSeeds checks that Bitcoin Cash pages have the keys and the legacy addresses
+// of the Bitcoin page with the same number
+func TestBitcoinCash_sameSeeds(t *testing.T) {
+	bitcoinCashRows, _ := GenerateRows(BitcoinCash, "last", 20)
+	bitcoinRows, _ := GenerateRows(Bitcoin, "last", 20)
+
+	for i, row := range bitcoinCashRows {
+		want := []string{bitcoinRows[i][0], bitcoinRows[i][1], bitcoinRows[i][2], bitcoinRows[i][8]}
+
+		if got := []string{row[0], row[3], row[4], row[5]}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected: %v", want)
+			t.Errorf("Actual:   %v", got)
+		}
+	}
+}
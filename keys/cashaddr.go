@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// cashAddrP2PKH is the CashAddr type of the version byte for a public key hash
+const cashAddrP2PKH byte = 0
+
+var cashAddrGenerator = []uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+// encodeCashAddr encodes a 20 byte hash as a CashAddr address with its prefix, e.g.
+// bitcoincash:q…. The version byte has the type in bits 3 to 6 and the size of a 160 bit hash, 0.
+func encodeCashAddr(prefix string, addrType byte, hash []byte) (string, error) {
+	payload, err := bech32.ConvertBits(append([]byte{addrType << 3}, hash...), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	// The checksum covers the lower 5 bits of the prefix, a zero separator, the payload and 8 zeros
+	values := make([]byte, 0, len(prefix)+1+len(payload)+8)
+
+	for i := 0; i < len(prefix); i++ {
+		values = append(values, prefix[i]&31)
+	}
+
+	values = append(values, 0)
+	values = append(values, payload...)
+	values = append(values, 0, 0, 0, 0, 0, 0, 0, 0)
+
+	polymod := cashAddrPolymod(values) ^ 1
+
+	var sb strings.Builder
+
+	sb.WriteString(prefix)
+	sb.WriteByte(':')
+
+	for _, b := range payload {
+		sb.WriteByte(bech32Charset[b])
+	}
+
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(7-i)))&31])
+	}
+
+	return sb.String(), nil
+}
+
+// cashAddrPolymod is the BCH code of the CashAddr checksum, a 40 bit version of bech32Polymod
+func cashAddrPolymod(values []byte) uint64 {
+	chk := uint64(1)
+
+	for _, v := range values {
+		top := chk >> 35
+		chk = (chk&0x07ffffffff)<<5 ^ uint64(v)
+
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= cashAddrGenerator[i]
+			}
+		}
+	}
+
+	return chk
+}
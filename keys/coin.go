@@ -0,0 +1,132 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// Coin is a chain whose keys are listed on pages. The commands, the output formats and the server
+// work with every registered coin, see RegisterCoin.
+type Coin interface {
+	// Name is the name of the commands and routes of the coin, e.g. btc
+	Name() string
+	// Title is the name of the coin in help texts and page titles, e.g. Bitcoin
+	Title() string
+	// Networks returns the names of the networks of the coin, the first one is the default.
+	// A coin without networks returns nil.
+	Networks() []string
+	// Network returns the coin on a network, an empty name is the default network
+	Network(name string) (Coin, error)
+	// Keyspace returns the keyspace of the pages of the coin
+	Keyspace(keysPerPage int) (*Keyspace, error)
+	// Columns returns the names of the fields of a row
+	Columns() []string
+	// Row returns the fields of the row of a seed, in the order of Columns
+	Row(seed *big.Int) []string
+	// ParsePrivateKey parses a private key that is searched for
+	ParsePrivateKey(input string) (PrivateKey, error)
+}
+
+var registry = struct {
+	sync.RWMutex
+	coins []Coin
+}{}
+
+func init() {
+	RegisterCoin(Bitcoin)
+	RegisterCoin(Ethereum)
+	RegisterCoin(Litecoin)
+	RegisterCoin(Dogecoin)
+	RegisterCoin(Dash)
+	RegisterCoin(BitcoinCash)
+	RegisterCoin(Tron)
+	RegisterCoin(Combined)
+}
+
+// RegisterCoin adds a coin to the registry, it panics if a coin with the same name is registered
+func RegisterCoin(coin Coin) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	for _, c := range registry.coins {
+		if c.Name() == coin.Name() {
+			panic(fmt.Sprintf("keys: coin %q is registered twice", coin.Name()))
+		}
+	}
+
+	registry.coins = append(registry.coins, coin)
+}
+
+// Coins returns the registered coins in the order they were registered
+func Coins() []Coin {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return append([]Coin(nil), registry.coins...)
+}
+
+// LookupCoin returns the registered coin with a name
+func LookupCoin(name string) (Coin, bool) {
+	for _, coin := range Coins() {
+		if coin.Name() == name {
+			return coin, true
+		}
+	}
+
+	return nil, false
+}
+
+// CoinNames returns the names of the registered coins, e.g. "btc, eth, ltc, doge, dash, bch, trx or all"
+func CoinNames() string {
+	var names []string
+	for _, coin := range Coins() {
+		names = append(names, coin.Name())
+	}
+
+	return orList(names)
+}
+
+// GenerateRows returns the rows of a page of a coin, pages after the last page return ErrPageOutOfRange
+func GenerateRows(coin Coin, pageNumber string, keysPerPage int) ([][]string, error) {
+	keyspace, err := coin.Keyspace(keysPerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := keyspace.Page(pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	firstSeed, count := keyspace.PageSeeds(page)
+
+	var rows [][]string
+
+	for i := 0; i < count; i++ {
+		rows = append(rows, coin.Row(firstSeed))
+
+		firstSeed.Add(firstSeed, one)
+	}
+
+	return rows, nil
+}
+
+// noNetworks is the Network method of a coin without networks
+func noNetworks(coin Coin, name string) (Coin, error) {
+	if name != "" {
+		return nil, fmt.Errorf("%s has no network %q", coin.Title(), name)
+	}
+
+	return coin, nil
+}
+
+// orList joins names like "a, b or c"
+func orList(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
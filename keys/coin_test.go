@@ -0,0 +1,106 @@
+package keys
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestCoins(t *testing.T) {
+	var names []string
+	for _, coin := range Coins() {
+		names = append(names, coin.Name())
+	}
+
+	if want := []string{"btc", "eth", "ltc", "doge", "dash", "bch", "trx", "all"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected: %v", want)
+		t.Errorf("Actual:   %v", names)
+	}
+
+	if coin, ok := LookupCoin("eth"); !ok || coin != Ethereum {
+		t.Errorf("Expected to find eth, got %v", coin)
+	}
+
+	if _, ok := LookupCoin("xmr"); ok {
+		t.Errorf("Expected no coin named xmr")
+	}
+
+	if want := "btc, eth, ltc, doge, dash, bch, trx or all"; CoinNames() != want {
+		t.Errorf("Expected: %v", want)
+		t.Errorf("Actual:   %v", CoinNames())
+	}
+}
+
+func TestRegisterCoin_twice(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic for a coin that is registered twice")
+		}
+	}()
+
+	RegisterCoin(ethereumCoin{})
+}
+
+// TestGenerateRows checks that the rows of every coin have a value for every column, and that
+// the rows of Bitcoin and Ethereum are the keys of their generators
+func TestGenerateRows(t *testing.T) {
+	for _, coin := range Coins() {
+		for _, network := range append(coin.Networks(), "") {
+			coin, err := coin.Network(network)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rows, err := GenerateRows(coin, "last", 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, row := range rows {
+				if len(row) != len(coin.Columns()) {
+					t.Errorf("Expected %d %s values, got %d", len(coin.Columns()), coin.Name(), len(row))
+				}
+			}
+		}
+	}
+
+	bitcoin, _ := Bitcoin.Network("testnet")
+	bitcoinRows, _ := GenerateRows(bitcoin, "2", 5)
+	bitcoinKeys, _ := GenerateBitcoinKeys("2", 5, bitcoinNetworks["testnet"])
+
+	for i, key := range bitcoinKeys {
+		if !reflect.DeepEqual(bitcoinRows[i], key.Values()) {
+			t.Errorf("Expected: %v", key.Values())
+			t.Errorf("Actual:   %v", bitcoinRows[i])
+		}
+	}
+
+	ethereumRows, _ := GenerateRows(Ethereum, "1", 5)
+	ethereumKeys, _ := GenerateEthereumKeys("1", 5)
+
+	for i, key := range ethereumKeys {
+		if !reflect.DeepEqual(ethereumRows[i], key.Values()) {
+			t.Errorf("Expected: %v", key.Values())
+			t.Errorf("Actual:   %v", ethereumRows[i])
+		}
+	}
+}
+
+func TestCoin_Network(t *testing.T) {
+	if _, err := Bitcoin.Network("foonet"); err == nil {
+		t.Errorf("Expected an error for an unknown Bitcoin network")
+	}
+
+	if _, err := Ethereum.Network("mainnet"); err == nil {
+		t.Errorf("Expected an error for a network of a coin without networks")
+	}
+
+	combined, err := Combined.Network("signet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if row := combined.Row(big.NewInt(1)); row[0] != "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx" {
+		t.Errorf("Expected a testnet WIF, got %v", row[0])
+	}
+}
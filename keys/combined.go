@@ -0,0 +1,120 @@
+package keys
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// CombinedKey is one row of a combined page: every encoding of one private key
+type CombinedKey struct {
+	Bitcoin   BitcoinKey
+	Ethereum  EthereumKey
+	PublicKey PublicKey
+}
+
+// Combined is the coin of combined pages, every row has the keys of Bitcoin and Ethereum and the
+// public key. Its networks are the networks of Bitcoin.
+var Combined Coin = combinedCoin{bitcoin: bitcoinCoin{params: &chaincfg.MainNetParams}}
+
+type combinedCoin struct {
+	bitcoin bitcoinCoin
+}
+
+func (combinedCoin) Name() string {
+	return "all"
+}
+
+func (combinedCoin) Title() string {
+	return "Bitcoin and Ethereum"
+}
+
+func (c combinedCoin) Networks() []string {
+	return c.bitcoin.Networks()
+}
+
+func (c combinedCoin) Network(name string) (Coin, error) {
+	params, err := BitcoinNetwork(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return combinedCoin{bitcoin: bitcoinCoin{params: params}}, nil
+}
+
+func (combinedCoin) Keyspace(keysPerPage int) (*Keyspace, error) {
+	return CombinedKeyspace(keysPerPage)
+}
+
+// Columns are the columns of both coins, named after their coin, followed by the public key columns
+func (c combinedCoin) Columns() []string {
+	columns := append(coinColumns(c.bitcoin), coinColumns(Ethereum)...)
+
+	return append(columns, PublicKeyColumns()...)
+}
+
+func (c combinedCoin) Row(seed *big.Int) []string {
+	return combinedKeyFromSeed(seed, c.bitcoin.params).Values()
+}
+
+func (c combinedCoin) ParsePrivateKey(input string) (PrivateKey, error) {
+	return ParseNetworkPrivateKey(input, c.bitcoin.params)
+}
+
+// coinColumns prefixes the columns of a coin with its name, e.g. private becomes btcPrivate
+func coinColumns(coin Coin) []string {
+	var columns []string
+	for _, column := range coin.Columns() {
+		columns = append(columns, coin.Name()+strings.ToUpper(column[:1])+column[1:])
+	}
+
+	return columns
+}
+
+// Values returns the fields of the key in the order of the columns of Combined
+func (k CombinedKey) Values() []string {
+	values := append(k.Bitcoin.Values(), k.Ethereum.Values()...)
+
+	return append(values, k.PublicKey.Values()...)
+}
+
+// CombinedKeyspace returns the keyspace of combined pages, they list the same seeds as Bitcoin
+// pages so that a combined page has the same number as the Bitcoin page of its keys
+func CombinedKeyspace(keysPerPage int) (*Keyspace, error) {
+	return BitcoinKeyspace(keysPerPage)
+}
+
+// GenerateCombinedKeys returns the keys on a combined page, the Bitcoin keys are encoded for the
+// given network. Pages after the last page return ErrPageOutOfRange.
+func GenerateCombinedKeys(pageNumber string, keysPerPage int, params *chaincfg.Params) ([]CombinedKey, error) {
+	keyspace, err := CombinedKeyspace(keysPerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := keyspace.Page(pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	firstSeed, count := keyspace.PageSeeds(page)
+
+	var combinedKeys []CombinedKey
+
+	for i := 0; i < count; i++ {
+		combinedKeys = append(combinedKeys, combinedKeyFromSeed(firstSeed, params))
+
+		firstSeed.Add(firstSeed, one)
+	}
+
+	return combinedKeys, nil
+}
+
+func combinedKeyFromSeed(seed *big.Int, params *chaincfg.Params) CombinedKey {
+	return CombinedKey{
+		Bitcoin:   bitcoinKeyFromSeed(seed, params),
+		Ethereum:  ethereumKeyFromSeed(seed),
+		PublicKey: publicKeyFromSeed(seed),
+	}
+}
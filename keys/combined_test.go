@@ -0,0 +1,83 @@
+package keys
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestGenerateCombinedKeys(t *testing.T) {
+	combinedKeys, err := GenerateCombinedKeys("1", 2, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantKey := CombinedKey{
+		Bitcoin: BitcoinKey{
+			Private:           "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf",
+			PrivateCompressed: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn",
+			Compressed:        "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			Uncompressed:      "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm",
+			NestedSegwit:      "3JvL6Ymt8MVWiCNHC7oWU6nLeHNJKLZGLN",
+			RedeemScript:      "0014751e76e8199196d454941c45d1b3a323f1433bd6",
+			NativeSegwit:      "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			XOnlyPubKey:       "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			Taproot:           "bc1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5sspknck9",
+		},
+		Ethereum: EthereumKey{
+			Private: "0000000000000000000000000000000000000000000000000000000000000001",
+			Public:  "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+		},
+		PublicKey: PublicKey{
+			Compressed:   "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			Uncompressed: "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+			Hash160:      "751e76e8199196d454941c45d1b3a323f1433bd6",
+			Keccak:       "c0a6c424ac7157ae408398df7e5f4552091a69125d5dfcb7b8c2659029395bdf",
+		},
+	}
+
+	if len(combinedKeys) != 2 || !reflect.DeepEqual(combinedKeys[0], wantKey) {
+		t.Errorf("Expected: %#v", wantKey)
+		t.Errorf("Actual:   %#v", combinedKeys)
+	}
+}
+
+// TestGenerateCombinedKeys_sameSeed checks that a combined row has the Bitcoin and the Ethereum
+// keys of the same seed, Ethereum pages list them one row later because they start at seed 0
+func TestGenerateCombinedKeys_sameSeed(t *testing.T) {
+	combinedKeys, _ := GenerateCombinedKeys("last", 20, &chaincfg.TestNet3Params)
+	bitcoinKeys, _ := GenerateBitcoinKeys("last", 20, &chaincfg.TestNet3Params)
+
+	combinedKeyspace, _ := CombinedKeyspace(20)
+	ethereumKeyspace, _ := EthereumKeyspace(20)
+
+	firstSeed, _ := combinedKeyspace.PageSeeds(combinedKeyspace.LastPage())
+	ethereumPage, ethereumIndex := ethereumKeyspace.Locate(firstSeed)
+	ethereumKeys, _ := GenerateEthereumKeys(ethereumPage.String(), 20)
+
+	for i, key := range combinedKeys {
+		if key.Bitcoin != bitcoinKeys[i] {
+			t.Errorf("Expected: %#v", bitcoinKeys[i])
+			t.Errorf("Actual:   %#v", key.Bitcoin)
+		}
+
+		if ethereumIndex+i < len(ethereumKeys) && key.Ethereum != ethereumKeys[ethereumIndex+i] {
+			t.Errorf("Expected: %#v", ethereumKeys[ethereumIndex+i])
+			t.Errorf("Actual:   %#v", key.Ethereum)
+		}
+	}
+}
+
+func TestGenerateCombinedKeys_errors(t *testing.T) {
+	if _, err := GenerateCombinedKeys("0", 128, &chaincfg.MainNetParams); !errors.Is(err, ErrInvalidPage) {
+		t.Errorf("Expected: %v", ErrInvalidPage)
+		t.Errorf("Actual:   %v", err)
+	}
+
+	if _, err := GenerateCombinedKeys("last+1", 128, &chaincfg.MainNetParams); !errors.Is(err, ErrPageOutOfRange) {
+		t.Errorf("Expected: %v", ErrPageOutOfRange)
+		t.Errorf("Actual:   %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+// Package keys generates the pages of private keys that are listed on keys.lol and finds
+// the page that a given private key is listed on.
+//
+// A page is identified by a decimal page number (pages start at 1) and a number of keys per page.
+// Bitcoin pages start at seed 1, Ethereum pages start at seed 0. A Keyspace does the page math
+// for both, see BitcoinKeyspace and EthereumKeyspace. Seeds that are not valid private keys are
+// listed too, SeedScalar tells how their rows are derived.
+package keys
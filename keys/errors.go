@@ -0,0 +1,23 @@
+package keys
+
+import "errors"
+
+// The errors returned by this package wrap one of these, use errors.Is to tell them apart.
+var (
+	// ErrInvalidPage means the page number is not a decimal number of 1 or more
+	ErrInvalidPage = errors.New("invalid page number")
+
+	// ErrInvalidKeysPerPage means the number of keys per page is less than 1, or larger than a
+	// command accepts
+	ErrInvalidKeysPerPage = errors.New("invalid number of keys per page")
+
+	// ErrPageOutOfRange means the page is after the last page
+	ErrPageOutOfRange = errors.New("page out of range")
+
+	// ErrMalformedKey means the private key could not be decoded
+	ErrMalformedKey = errors.New("malformed private key")
+
+	// ErrKeyOutOfRange means the private key decoded fine but is outside the secp256k1 curve order,
+	// so it is not listed on any page
+	ErrKeyOutOfRange = errors.New("private key outside the curve order")
+)
@@ -0,0 +1,138 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// lastEthereumSeed is the last seed on Ethereum pages, keys.lol lists the 30 seeds after the curve
+// order on its last page
+var lastEthereumSeed = new(big.Int).Add(curveOrder, big.NewInt(30))
+
+// EthereumKey is one row of an Ethereum page
+type EthereumKey struct {
+	// Private is the hex encoded private key, left-padded to 64 characters
+	Private string
+	// Public is the EIP-55 checksummed address, empty when the seed is invalid
+	Public string
+}
+
+// Values returns the fields of the key in the order of the columns of Ethereum
+func (k EthereumKey) Values() []string {
+	return []string{k.Private, k.Public}
+}
+
+// Ethereum is the coin of Ethereum pages
+var Ethereum Coin = ethereumCoin{}
+
+type ethereumCoin struct{}
+
+func (ethereumCoin) Name() string {
+	return "eth"
+}
+
+func (ethereumCoin) Title() string {
+	return "Ethereum"
+}
+
+func (ethereumCoin) Networks() []string {
+	return nil
+}
+
+func (c ethereumCoin) Network(name string) (Coin, error) {
+	return noNetworks(c, name)
+}
+
+func (ethereumCoin) Keyspace(keysPerPage int) (*Keyspace, error) {
+	return EthereumKeyspace(keysPerPage)
+}
+
+func (ethereumCoin) Columns() []string {
+	return []string{"private", "public"}
+}
+
+func (ethereumCoin) Row(seed *big.Int) []string {
+	return ethereumKeyFromSeed(seed).Values()
+}
+
+func (ethereumCoin) ParsePrivateKey(input string) (PrivateKey, error) {
+	return ParsePrivateKey(input)
+}
+
+// GenerateEthereumKeys returns the keys on a page, pages after the last page return ErrPageOutOfRange.
+// The first page starts with the invalid seed 0 and the last page ends with seeds that wrap around
+// the curve order, see SeedScalar.
+func GenerateEthereumKeys(pageNumber string, keysPerPage int) ([]EthereumKey, error) {
+	keyspace, err := EthereumKeyspace(keysPerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := keyspace.Page(pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	firstSeed, count := keyspace.PageSeeds(page)
+
+	var ethereumKeys []EthereumKey
+
+	for i := 0; i < count; i++ {
+		ethereumKeys = append(ethereumKeys, ethereumKeyFromSeed(firstSeed))
+
+		firstSeed.Add(firstSeed, one)
+	}
+
+	return ethereumKeys, nil
+}
+
+func ethereumKeyFromSeed(seed *big.Int) EthereumKey {
+	key := EthereumKey{Private: fmt.Sprintf("%064x", seed)}
+
+	scalar, status := SeedScalar(seed)
+	if status == SeedInvalid {
+		return key
+	}
+
+	privateKey, _ := crypto.ToECDSA(seedBytes(scalar))
+
+	key.Public = crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	return key
+}
+
+// FindEthPrivateKeyPage returns the page that a hex encoded private key is on. The keys after the curve order
+// that are listed on the last page are found on the last page, larger keys return ErrKeyOutOfRange.
+func FindEthPrivateKeyPage(privateKey string, keysPerPage int) (string, error) {
+	location, err := LocateEthPrivateKey(privateKey, keysPerPage)
+	if err != nil {
+		return "", err
+	}
+
+	return location.Page.String(), nil
+}
+
+// LocateEthPrivateKey returns the page, the index and the seed of a hex encoded private key
+func LocateEthPrivateKey(privateKey string, keysPerPage int) (KeyLocation, error) {
+	if len(privateKey) > 64 {
+		return KeyLocation{}, fmt.Errorf("%w: hex private key is longer than 64 characters", ErrMalformedKey)
+	}
+
+	seed := new(big.Int)
+
+	if hex := strings.TrimLeft(privateKey, "0"); hex != "" {
+		if _, success := seed.SetString(hex, 16); !success {
+			return KeyLocation{}, fmt.Errorf("%w: invalid hex private key %q", ErrMalformedKey, privateKey)
+		}
+	}
+
+	keyspace, err := EthereumKeyspace(keysPerPage)
+	if err != nil {
+		return KeyLocation{}, err
+	}
+
+	return keyspace.LocateKey(seed)
+}
@@ -0,0 +1,443 @@
+package keys
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestGenerateEthereumKeys(t *testing.T) {
+	type args struct {
+		pageNumber  string
+		keysPerPage int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantKeys []EthereumKey
+	}{
+		{
+			"It can generate keys starting from the first page",
+			args{"1", 18},
+			[]EthereumKey{
+				{Private: "0000000000000000000000000000000000000000000000000000000000000000"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000001", Public: "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000002", Public: "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000003", Public: "0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000004", Public: "0x1efF47bc3a10a45D4B230B5d10E37751FE6AA718"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000005", Public: "0xe1AB8145F7E55DC933d51a18c793F901A3A0b276"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000006", Public: "0xE57bFE9F44b819898F47BF37E5AF72a0783e1141"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000007", Public: "0xd41c057fd1c78805AAC12B0A94a405c0461A6FBb"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000008", Public: "0xF1F6619B38A98d6De0800F1DefC0a6399eB6d30C"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000009", Public: "0xF7Edc8FA1eCc32967F827C9043FcAe6ba73afA5c"},
+				{Private: "000000000000000000000000000000000000000000000000000000000000000a", Public: "0x4CCeBa2d7D2B4fdcE4304d3e09a1fea9fbEb1528"},
+				{Private: "000000000000000000000000000000000000000000000000000000000000000b", Public: "0x3DA8D322CB2435dA26E9C9fEE670f9fB7Fe74E49"},
+				{Private: "000000000000000000000000000000000000000000000000000000000000000c", Public: "0xDbc23AE43a150ff8884B02Cea117b22D1c3b9796"},
+				{Private: "000000000000000000000000000000000000000000000000000000000000000d", Public: "0x68E527780872cda0216Ba0d8fBD58b67a5D5e351"},
+				{Private: "000000000000000000000000000000000000000000000000000000000000000e", Public: "0x5A83529ff76Ac5723A87008c4D9B436AD4CA7d28"},
+				{Private: "000000000000000000000000000000000000000000000000000000000000000f", Public: "0x8735015837bD10e05d9cf5EA43A2486Bf4Be156F"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000010", Public: "0xfaE394561e33e242c551d15D4625309EA4c0B97f"},
+				{Private: "0000000000000000000000000000000000000000000000000000000000000011", Public: "0x252Dae0A4b9d9b80F504F6418acd2d364C0c59cD"},
+			},
+		},
+		{
+			"It can generate keys for the last page",
+			args{"904625697166532776746648320380374280100293470930272690489102837043110636675", 128},
+			[]EthereumKey{
+				// 96 keys on the last page
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364100", Public: "0xbbF3316f2Fa21d9e0A8a07F5047F37A467f01a5B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364101", Public: "0xFB7DC16619EdD43a08eFD9cE20De94c94682D13a"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364102", Public: "0xd79dc898B43e1404Beb1471D6d566F9F981f118F"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364103", Public: "0x3c62B34927d02A4e55379293488Be63cE69b05F8"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364104", Public: "0xd259E3B2470098EE45B70673630a1922f638761e"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364105", Public: "0x956bcC8D3a53E1A80f729802431D501c23Aa9276"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364106", Public: "0xE9A97ebdE002Cdbd4ef86d61cd479f7B36DBb1e7"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364107", Public: "0xA036362f74E84039D2702ec4e2c2750aa1F43fEf"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364108", Public: "0xf32746816286f894981122e02E2640569f824fC0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364109", Public: "0x279661BD2Cbf7675A51b42Ab08801EB718D99Cc1"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036410a", Public: "0x246D6FbCBf9601f55A7e2DaC06eE6BDe84CF2120"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036410b", Public: "0x7eC80c82DA721Fed253bB16A0ACBbDb269409E5E"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036410c", Public: "0x1F5Ce48feCEEA16759D22c4f52c90204974411FF"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036410d", Public: "0x19026841bb9B57587e120CE13FaE9Dd7C20B7F32"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036410e", Public: "0xF82867F877acf02EAb87886Ac1F4ffcF48d03962"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036410f", Public: "0x76618F5A6eE6C138eBfC2AdF8e92367A9A21d1A6"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364110", Public: "0xdb108Df98704cCF44Fe13e25F08B0A0AA230B9A6"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364111", Public: "0x9db2dE6864185CdECA7d6709406F3E1acCfFD5dB"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364112", Public: "0x7D8D458D014aC223de08d2F80D25438901f15c82"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364113", Public: "0x603F312db28F24FEAC8f539dCA8cAb442407D356"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364114", Public: "0xFC8705Eff1d89Cc66Cd0B2CaC3FA8c986Ab96EE3"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364115", Public: "0x980545ad727dC273B51E0a5352586fA5Cd548683"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364116", Public: "0x48442b572C9339923a9BCcBd09612B160CD15849"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364117", Public: "0x32D5f8FCD62ffA771b1DB65E7C2211e9DEfD348F"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364118", Public: "0x0255b88a30dE3Db1d5b6D63d5343114c6Ce140c4"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364119", Public: "0x9D990c3d16241DACb92f36e8E3eAC450eca4935E"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036411a", Public: "0xEf2E1F33EbD377B6AcB5470F82A120aC23061E31"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036411b", Public: "0xBA5935b3BC656E62158A1077246135d6E1A10Df8"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036411c", Public: "0x3fb21F5f512D614328CBe1196177A1Dd80da1e90"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036411d", Public: "0x1a7a11C766A414B66F9C4D59a36D7e730E4Bca1D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036411e", Public: "0x233987e78A38D754C44816643e96Ca1e5815dAeA"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036411f", Public: "0x6a716064358CDAb0009010E05DC6aF539ab53d8A"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364120", Public: "0x95B1fD7b3879CD52ffd36F948AF67166D08cDF11"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364121", Public: "0x6687C40EE5F12F7916Db9E2368534Cb0040CF3e4"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364122", Public: "0xb419888537465EB564662e4CB5bf2E7400c9ECc7"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364123", Public: "0x142110Ba8A897a0212efEea44BF4acB8Ea80462e"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364124", Public: "0x7c1e26881DA999Ac729695a47F909DC1BaD2cec0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364125", Public: "0xde0073Ce497e7eAEe5ea97798D823B2D2C723f71"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364126", Public: "0x0E2511Dd112A63Cf18c3513B23316e011Afc3afE"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364127", Public: "0x0de7755E7475097F42DA221bFb153Eafba2E9F5D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364128", Public: "0xcfF97B2D79Ded7D1dB9502cBF0706935B2a78656"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364129", Public: "0x84289d222E4765fFF2Be4e406800Ed4465D4845B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036412a", Public: "0x9bD05480754b3D5816984CAc5E88e60497657199"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036412b", Public: "0xB0211d6477BeA0c686Bd6E407eab5cE37aCcA893"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036412c", Public: "0x7c51c9A72Cb650e159215A54d6d9D69a69547b5A"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036412d", Public: "0x4AB5e175Cdd5B31AA1044D7a7Bba0B90CB9208Cb"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036412e", Public: "0xe20307eF6c7b1E5428aC7ca9873dfD1850A147d2"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036412f", Public: "0x1475e0534C40F7AAE5DaefB0D2C9Ab58FB01eb8F"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364130", Public: "0xD5ea7A94F67d24171b40987f99D26C5DD762596C"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364131", Public: "0x44E9F52C16F2b5f232543EDBFC8e9837931D33B3"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364132", Public: "0xE8DE258b404F7D5116DB7bFaA1F7F4C8208C2BcD"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364133", Public: "0xF66B31d0638d8558c04d75F3F857095e5048F166"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364134", Public: "0xAD98c8a3FA5bB03C8C249a0B3e727E8503333Fd2"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364135", Public: "0xb67983fE9CCE1EF4fa6E5B339c5FF5B2A9b27395"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364136", Public: "0x5c6bD1597b1411cce0e79A0841FD11073120493B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364137", Public: "0xdF8e88eB567f6C901491fDE5636b4bD7611Bd873"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364138", Public: "0xBDc7a9D74e7194E279bCde320496dDB314Ac4303"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364139", Public: "0x6023eB78B679DAF4f8e14E096e97774f75c5140E"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413a", Public: "0xcA193534a86C4e536722676E3F92E03804A436d0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413b", Public: "0xdc6999513539883ee37f4f1a0a2Ad573812B6A68"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413c", Public: "0x941171032778e26a70A00Da92b841a6C7fB5b676"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413d", Public: "0xb69f25896e3CFac20C89eC1Ce8866F4eB2828c36"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413e", Public: "0x2Ef1f47E3244806c0FAf4Bd42D96cD1e05AefFeC"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413f", Public: "0x92D48Ff5523c9B04Aa426191b4bD21e6080F074A"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140", Public: "0x80C0dbf239224071c59dD8970ab9d542E3414aB2"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364142", Public: "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364143", Public: "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364144", Public: "0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364145", Public: "0x1efF47bc3a10a45D4B230B5d10E37751FE6AA718"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364146", Public: "0xe1AB8145F7E55DC933d51a18c793F901A3A0b276"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364147", Public: "0xE57bFE9F44b819898F47BF37E5AF72a0783e1141"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364148", Public: "0xd41c057fd1c78805AAC12B0A94a405c0461A6FBb"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364149", Public: "0xF1F6619B38A98d6De0800F1DefC0a6399eB6d30C"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036414a", Public: "0xF7Edc8FA1eCc32967F827C9043FcAe6ba73afA5c"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036414b", Public: "0x4CCeBa2d7D2B4fdcE4304d3e09a1fea9fbEb1528"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036414c", Public: "0x3DA8D322CB2435dA26E9C9fEE670f9fB7Fe74E49"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036414d", Public: "0xDbc23AE43a150ff8884B02Cea117b22D1c3b9796"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036414e", Public: "0x68E527780872cda0216Ba0d8fBD58b67a5D5e351"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036414f", Public: "0x5A83529ff76Ac5723A87008c4D9B436AD4CA7d28"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364150", Public: "0x8735015837bD10e05d9cf5EA43A2486Bf4Be156F"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364151", Public: "0xfaE394561e33e242c551d15D4625309EA4c0B97f"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364152", Public: "0x252Dae0A4b9d9b80F504F6418acd2d364C0c59cD"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364153", Public: "0x79196B90D1E952C5A43d4847CAA08d50b967c34A"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364154", Public: "0x4bd1280852Cadb002734647305AFC1db7ddD6Acb"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364155", Public: "0x811da72aCA31e56F770Fc33DF0e45fD08720E157"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364156", Public: "0x157bFBEcd023fD6384daD2Bded5DAD7e27Bf92E4"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364157", Public: "0x37dA28C050E3c0A1c0aC3BE97913EC038783dA4C"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364158", Public: "0x3Bc8287F1D872df4217283b7920D363F13Cf39D8"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364159", Public: "0xf4e2B0fcbd0DC4b326d8A52B718A7bb43BdBd072"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415a", Public: "0x9a5279029e9A2D6E787c5A09CB068AB3D45e209d"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415b", Public: "0xc39677F5F47d5fE65ab24e66750e8FCa127c15BE"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415c", Public: "0x1dc728786E09F862E39Be1f39dD218EE37feB68D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415d", Public: "0x636CC65783084b9F370789c90F733DBBeb88925D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415e", Public: "0x4a7A7c2E09209dbE44A582cD92b0eDd7129E74be"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415f", Public: "0xA56160A359F2EAa66f5c9df5245542B07339A9a6"},
+			},
+		},
+		{
+			"It can generate keys for the second to last page",
+			args{"904625697166532776746648320380374280100293470930272690489102837043110636674", 128},
+			[]EthereumKey{
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364080", Public: "0x24c28b494275D758d9eCc27aD4A54EB7a5E19f88"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364081", Public: "0xc0Fc35d6B3D9Bc95ab36B55C0d1e429491A4e4D8"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364082", Public: "0x5E8dc623b42c4bBA6f804D104d9B41D323bA5f1C"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364083", Public: "0x8fb4b8eB836C2b9D72F17B7fB5B3Dc2417a1481d"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364084", Public: "0x464bb6ee22d547f9183ed0DfDD06d30c10A54714"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364085", Public: "0xaf4Ff8938215795bA24F4533CFaEbCB15FA72d84"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364086", Public: "0x8A73654C5b16E2645178420Bf298Bdf5E23535F9"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364087", Public: "0x4fC7105Cf594A471077475D28b03174DE27d4fC7"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364088", Public: "0x7CCA5C103879204458CAEeed10A09a2629C908f2"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364089", Public: "0xD13E9B95F69b5E9553554d79cf1AF5Ca35dda38A"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036408a", Public: "0x45eC01EdeC168643DE5999bEEccF394A37DEd4BF"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036408b", Public: "0xC961Cd443Eb275f9F6856f3e5E1e1a1DC12fcC89"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036408c", Public: "0x3dFfcC0bdd956A419405B675C33E65f944529EE0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036408d", Public: "0x54cE04Df9e8127bbE04c6C4f6d819B1060F5C14e"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036408e", Public: "0xfCe9F8ddDdfAB9C6538ff2cD05F76ded16518905"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036408f", Public: "0x1b817fA3735C57f59d0c9D3e6e4e1A4e4a6F020a"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364090", Public: "0xd7a0Bbd722a302b2B4079abc962d839c6Ad3A7E2"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364091", Public: "0x8597d471D161C1606a3C5A8d7f9dbb7DcBa29f64"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364092", Public: "0xF6F2a0752CD1162f836ed6C12D7716d4BDe2D45c"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364093", Public: "0x5A0A69269F0764cC01539997511418d73DF6Adb9"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364094", Public: "0xa6BAD5AC97fdA351a784c38D557014c267596BA3"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364095", Public: "0xE703F0102927c5F0Fb828C429e43f91C7549c54D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364096", Public: "0x22F274009d12E0ECc0D9866ce6424910E46DbA39"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364097", Public: "0x036f5bE2A77B5a40De057564faC86ccAc1Ecc105"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364098", Public: "0xC4Ca0853326D03C00C221780fEA5Bd7b057EAe73"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364099", Public: "0xb39e749993E1f4952812Bd2eeF05331D8ea7e04A"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036409a", Public: "0x636D9D40b1F0eec51F0af83e0ae8962fE87b0E55"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036409b", Public: "0xAb34dF310aefCF51aff94574D19E88B191555be0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036409c", Public: "0xa27229415a22854d4c045eb864210C42c160D4Af"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036409d", Public: "0xfCE982a5Ae7918EfC275817E41044e02783DF9b1"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036409e", Public: "0x0248479ee063Ed471E6fA743416975096CeDd1Af"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036409f", Public: "0x2D729b4897967f05fBC9ABEBE5f3990e79aAbd8e"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a0", Public: "0xf8961751715D7824B636d09d1aeD94db1358070b"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a1", Public: "0x802e217Dae478aCB08F6083Ac9F6978804cc96Fa"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a2", Public: "0x557659b033587f5F88A798543c751522da9A77c3"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a3", Public: "0x2B4701117AC9A9D83F20091A82c193776938b33a"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a4", Public: "0x808632B8C1b6B71474Bf802AC5330338d991E262"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a5", Public: "0x2AEDF2E5E44ff1E921e06258168C20C0297BE0b0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a6", Public: "0x4c5D822457F031D0D837Af6FD016487Ac808501B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a7", Public: "0xae92713086df0115dE138A1803c1830ebA24c04c"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a8", Public: "0xf2dDf8403B63E793eefE5776F775218D644A6Ed4"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640a9", Public: "0x9Fe41B5675101d215CbD65b952829255F5Fd190B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640aa", Public: "0x8C43dc73bbda7c745acfc4c5fd5DA0Fb8b0789DD"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ab", Public: "0x6dDbC88dD571a3D62354F4697EE94FA2F674F60b"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ac", Public: "0x49Ad143cd3e1A50044A603e52Caf9aAb45F9039e"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ad", Public: "0xf0dF3E487a4DfE1E0c1A8D79Ae9a6D2246F4c132"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ae", Public: "0x6E13371f15a952AA59ce3081832350f29824DD3D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640af", Public: "0xF5e2e4B23f5E402E7bf8cB6B030132843627dabc"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b0", Public: "0x547e8B70462cd11fedF450DbABE27606335208C7"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b1", Public: "0x9a5Cf8caf63325B601eDD16FF3aFE35E19C33D4B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b2", Public: "0x05e7F54281E28358F9F80d94224119C366921baE"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b3", Public: "0x3A42E3DA23548C1e869D2DC296905D830be2aeDe"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b4", Public: "0x1E4E79D2e4EDE040ed7F4ECfEAf0dCcA009c0Cf1"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b5", Public: "0x6afa631b162c62A63E871cafBDEf97aa8c4F17d6"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b6", Public: "0x894883b7CccB4380886d6F266bd4B31e5116dBB6"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b7", Public: "0xd41a68cD6d5468885Ca4106E9c091053E4B98712"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b8", Public: "0x082144C36517bE5567a211458A2f9CE8dd3C9631"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640b9", Public: "0xB3421D04D4CEf251748e52554f7bB6898162457D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ba", Public: "0x77dc4a011db73b747b6C35831F9A3d708C4Bc06b"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640bb", Public: "0xE45eC6A57aF401025780082066fb8b0e852948Ea"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640bc", Public: "0x6694e6f4aeb487396d6380e16aE7045A6959a08F"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640bd", Public: "0x36826A179bc0F443F3BE335b950E5984fe66A0E9"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640be", Public: "0x7C2dAd3C003D0D7f2754240dCde0AE85D8966891"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640bf", Public: "0xe3Dd2A563D497B1bd13F0990801F872126EF9619"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c0", Public: "0x3c14170974F780573a8EAA4a898b5f7295af388C"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c1", Public: "0x0436F67BE14Fe01581Ec95cfdd258292B612b1e0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c2", Public: "0xA8A5D0d6B212D0E3400b2CF5797F9069E9D242f8"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c3", Public: "0xFcDa3687cB8EDDA171bB4E0e77dD8e5c4f0bEB36"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c4", Public: "0x7B70043eA73A741Fbb0308b0f144b9d558F1ee6d"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c5", Public: "0xbf3920FC367E6DeD48205922E6BcAA5094C11984"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c6", Public: "0x63D61B4b88394813a6Eb57F12F7eb814B2C55559"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c7", Public: "0xa8b0137B4993550507b82bDe299769E6FCB06bc0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c8", Public: "0x800111796Cf8CF1fe19d1A88A8b14e61ABb2F64c"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640c9", Public: "0x489db12C6494Cb82f4B4595AF8e1cc03c7aCe7BC"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ca", Public: "0x5dA264B9c694025e493b88a74d24926B6D820046"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640cb", Public: "0x6a404a4dDE355Bd5A70DBe2496E41f86BF7195B7"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640cc", Public: "0xdACc0CD0A39F5790ebDD89338fD89D997109079B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640cd", Public: "0x49CdA6da93eDA358DdD5bBF9fc9CEdE1bff7CF37"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ce", Public: "0x74997224c265153206A3583389853d4875eAF2f0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640cf", Public: "0x16F961B881c4aa185437dC788411966267743599"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d0", Public: "0xc56bAF7ae68d346E1C3e1E4FADACA482922C689D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d1", Public: "0xc046AcF78Fb69A459509C88c22bb589C3dA47f1E"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d2", Public: "0x93dD9E9C613A612294F66F86d6B4f745Fb9613e3"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d3", Public: "0xcB1FA10AC9BD63ED02f6c4c208A6ed4C38DDd2F7"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d4", Public: "0x132481670A563Ebc85F1780aD0ce3aD7C8Ec8550"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d5", Public: "0x6deDf2f67d8D2416bA27529465Ac3799A685cD94"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d6", Public: "0x147ecbA866a76C06e5Df312464820B3F0ac22336"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d7", Public: "0xf7fE46bb9DCb35e84f862B3f0f29ad88f98729D3"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d8", Public: "0x01C45FF9b24b1A30c7a67a47AF35f20Ee6E861Df"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640d9", Public: "0x08b677c1702f893d7af551CcF0aB73Cc76b08725"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640da", Public: "0x9c9B8ad417948F4992917604D5e474A0985Da72B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640db", Public: "0xDe4115CEd57a730a98Aeb51262272F70176D517c"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640dc", Public: "0x2376A74C7e27734513232fCcB9BF4987FFd32828"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640dd", Public: "0x85C42EBbc874B4d7Bd41B422ba52cba74005a708"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640de", Public: "0x64499795Be8f25f7835355FAd147ef4bE67A63eA"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640df", Public: "0x5e217cedC12065090504E6fefFfD4F34b5392C96"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e0", Public: "0x0046d140192095d0d5a496A1c6aeFe505cB97b25"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e1", Public: "0x803E207333090038357df8850B6505082C22202c"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e2", Public: "0x1D008738A1a2edDDCCc647414a012952C7aaa703"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e3", Public: "0xc24e4Cb2cD7aF8652014A5895d718DE3989Ec908"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e4", Public: "0x3534CE8B77D3a6763F621eE5E695AC1F069939C1"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e5", Public: "0xbFc243E6A1AD1C0849619836803fD4ec69aA3627"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e6", Public: "0x1EB41699Bb4Cf60562016b2feA19F892b747bf7e"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e7", Public: "0x7F9b7b09b2FD7dFfA4cE34eEf655fEED77C262Cf"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e8", Public: "0x17C2Be1182AdC7856927a874483d34872941e26A"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640e9", Public: "0xCacd403c3A66ee51D70D988C3a205a8B55632c08"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ea", Public: "0xE4ce5B9B8eb22168490456c04fEc9BF8B9351226"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640eb", Public: "0xdC283e9cE10796590409904014A6ca5A652A3fe7"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ec", Public: "0x6c670CBdD33A15AfCA8A123ddafD2E5bd076b320"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ed", Public: "0xc632AE04355AF36c642b2Db29e37fA2E47290B46"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ee", Public: "0xE847f260CA14716206Bf35A855Eec2A231Ce4829"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ef", Public: "0x0BF649ce3f9c67c700f35019495EC129B2adC25f"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f0", Public: "0x53E2BBeF6240F9c4f7DDA12B8ca548d51d9faa5D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f1", Public: "0xeE3744837d7e2bC55061a90cd7eE9f2249CA83EC"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f2", Public: "0xb8bc3965F623dc485DA38f3CA5dBcFF905911C14"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f3", Public: "0x4fBA73b1cA808602e2472A91eA70CAa3C1d599e0"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f4", Public: "0x9cc1bA4282517CE299a50C024e3D3b2e6062c299"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f5", Public: "0x4430BaeD72189129eAa9c6D521B601Fe4eb21E86"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f6", Public: "0xbF6f1e7389dcBf98F36C30643591B3556e4A862E"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f7", Public: "0xa96020783A4366Fa30E62322Ae31113C5ceEE49D"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f8", Public: "0x9694dc44cF34bFE044f13C1E553fC51b9c4e9F17"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640f9", Public: "0xB9ea59981494040e4E01c79F2aE94c0A3F306C7C"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640fa", Public: "0x31A22784aB2Eaae663390BD3876cA744f8993189"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640fb", Public: "0x47236b8BEbBFe5d8555eF87b10463509C0284272"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640fc", Public: "0xDdE3c33431448B7e1053Fe20dCD1C2fc61e9cf1B"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640fd", Public: "0xB4eFf29840cDcfF388890D0C923a7b76F66e24c6"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640fe", Public: "0xf179d1bAAdF6B4f045aFf53F61783B6DC5012035"},
+				{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd03640ff", Public: "0x02396f902B7C3aE09AE37155f7287a4a3F498f66"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKeys, err := GenerateEthereumKeys(tt.args.pageNumber, tt.args.keysPerPage)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("Expected:")
+				for _, expectedKey := range tt.wantKeys {
+					t.Errorf("%#v", expectedKey)
+				}
+
+				t.Errorf("Actual:")
+				for _, actualKey := range gotKeys {
+					t.Errorf("%#v", actualKey)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateEthereumKeys_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		pageNumber string
+		wantErr    error
+	}{
+		{"It rejects a page that is not a number", "0xzz", ErrInvalidPage},
+		{"It rejects page zero", "0", ErrInvalidPage},
+		{"It rejects a negative page", "-5", ErrInvalidPage},
+		{"It rejects a page after the last page", "904625697166532776746648320380374280100293470930272690489102837043110636676", ErrPageOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GenerateEthereumKeys(tt.pageNumber, 128); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+		})
+	}
+}
+
+func TestFindEthPrivateKeyPage(t *testing.T) {
+	type args struct {
+		privateKey  string
+		keysPerPage int
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		wantPage string
+	}{
+		{
+			"It can find the page that a random private key is on",
+			args{"e44a4bdc91d35496190474dca11338059ffbab72d3a72f195c4a030632d49503", 128},
+			"806707810447654934665982607721811039665835129933115297248770801612223785259",
+		},
+		{
+			"It can find a key on the first page",
+			args{"0000000000000000000000000000000000000000000000000000000000000001", 128},
+			"1",
+		},
+		{
+			"It can find the first key on the first page",
+			args{"0000000000000000000000000000000000000000000000000000000000000000", 128},
+			"1",
+		},
+		{
+			"It can find a key on the last page",
+			args{"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140", 128},
+			"904625697166532776746648320380374280100293470930272690489102837043110636675",
+		},
+		{
+			"It can find the last key on the last page",
+			args{"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036415f", 128},
+			"904625697166532776746648320380374280100293470930272690489102837043110636675",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, err := FindEthPrivateKeyPage(tt.args.privateKey, tt.args.keysPerPage)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotPage, tt.wantPage) {
+				t.Errorf("Expected: %v", tt.wantPage)
+				t.Errorf("Actual:   %v", gotPage)
+			}
+		})
+	}
+}
+
+func TestFindEthPrivateKeyPage_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey string
+		wantErr    error
+	}{
+		{"It rejects a key that is not hex", "e44a4bdc91d35496190474dca11338059ffbab72d3a72f195c4a030632d4950z", ErrMalformedKey},
+		{"It rejects a key that is too long", "0e44a4bdc91d35496190474dca11338059ffbab72d3a72f195c4a030632d495030", ErrMalformedKey},
+		{"It rejects a key after the last listed key", "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364160", ErrKeyOutOfRange},
+		{"It rejects the largest 256 bit key", "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", ErrKeyOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FindEthPrivateKeyPage(tt.privateKey, 128); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+		})
+	}
+}
+
+func TestFindEthPrivateKeyPage_keysPerPage(t *testing.T) {
+	// every key that is generated on a page is found on that page, for any page size
+	for _, keysPerPage := range []int{1, 7, 50, 128} {
+		keyspace, _ := EthereumKeyspace(keysPerPage)
+
+		for _, pageNumber := range []string{"1", "2", keyspace.LastPage().String()} {
+			ethereumKeys, err := GenerateEthereumKeys(pageNumber, keysPerPage)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, key := range ethereumKeys {
+				if gotPage, _ := FindEthPrivateKeyPage(key.Private, keysPerPage); gotPage != pageNumber {
+					t.Errorf("Expected %v to be on page %v of %d keys, got %v", key.Private, pageNumber, keysPerPage, gotPage)
+				}
+			}
+		}
+	}
+}
+
+func TestLocateEthPrivateKey(t *testing.T) {
+	// every key is found at its row of the page that lists it
+	for _, keysPerPage := range []int{1, 7, 128} {
+		keyspace, _ := EthereumKeyspace(keysPerPage)
+
+		for _, pageNumber := range []string{"1", "2", keyspace.LastPage().String()} {
+			ethereumKeys, _ := GenerateEthereumKeys(pageNumber, keysPerPage)
+			firstSeed, _ := keyspace.PageSeeds(mustPage(t, keyspace, pageNumber))
+
+			for i, key := range ethereumKeys {
+				location, err := LocateEthPrivateKey(key.Private, keysPerPage)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				wantSeed := new(big.Int).Add(firstSeed, big.NewInt(int64(i)))
+
+				if location.Page.String() != pageNumber || location.Index != i || location.Seed.Cmp(wantSeed) != 0 {
+					t.Errorf("Expected %v at page %v index %d seed %v", key.Private, pageNumber, i, wantSeed)
+					t.Errorf("Actual:   page %v index %d seed %v", location.Page, location.Index, location.Seed)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var one = big.NewInt(1)
+
+// parsePage parses a page expression, pages start at 1. An expression is a decimal or 0x hex
+// page number, "first" or "last", optionally followed by a decimal or hex offset: "last-10",
+// "first+5", "0x10+1". Expressions with an offset may fall before the first page or after
+// the last page, the caller checks them against the keyspace.
+func parsePage(expression string, first, last *big.Int) (*big.Int, error) {
+	base, sign, offset := expression, "", ""
+
+	if i := strings.LastIndexAny(expression, "+-"); i > 0 {
+		base, sign, offset = expression[:i], expression[i:i+1], expression[i+1:]
+	}
+
+	var page *big.Int
+
+	switch strings.ToLower(base) {
+	case "first":
+		page = new(big.Int).Set(first)
+	case "last":
+		page = new(big.Int).Set(last)
+	default:
+		var ok bool
+		if page, ok = parseNumber(base); !ok || page.Sign() <= 0 {
+			return nil, fmt.Errorf("%w %q", ErrInvalidPage, expression)
+		}
+	}
+
+	if sign == "" {
+		return page, nil
+	}
+
+	steps, ok := parseNumber(offset)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrInvalidPage, expression)
+	}
+
+	if sign == "-" {
+		return page.Sub(page, steps), nil
+	}
+
+	return page.Add(page, steps), nil
+}
+
+// parseNumber parses a decimal number or a hex number with a 0x prefix, without a sign.
+// Leading zeros are decimal, unlike big.Int.SetString with base 0.
+func parseNumber(s string) (*big.Int, bool) {
+	if s == "" || s[0] == '+' || s[0] == '-' {
+		return nil, false
+	}
+
+	base := 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s, base = s[2:], 16
+	}
+
+	if s == "" || s[0] == '+' || s[0] == '-' || strings.Contains(s, "_") {
+		return nil, false
+	}
+
+	return new(big.Int).SetString(s, base)
+}
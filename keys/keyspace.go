@@ -0,0 +1,230 @@
+package keys
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// KeyLocation is where a private key is listed
+type KeyLocation struct {
+	Page  *big.Int
+	Index int // zero-based row of the key on its page
+	Seed  *big.Int
+}
+
+// Keyspace numbers a contiguous range of seeds into pages of a fixed number of keys.
+// Pages start at 1 and the index of a key on its page starts at 0.
+type Keyspace struct {
+	first       *big.Int
+	last        *big.Int
+	keysPerPage *big.Int
+}
+
+// NewKeyspace returns the keyspace of the seeds from first to last, both included
+func NewKeyspace(first, last *big.Int, keysPerPage int) (*Keyspace, error) {
+	if keysPerPage < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidKeysPerPage, keysPerPage)
+	}
+
+	if first.Cmp(last) > 0 {
+		return nil, fmt.Errorf("first seed %s is after last seed %s", first, last)
+	}
+
+	return &Keyspace{
+		first:       new(big.Int).Set(first),
+		last:        new(big.Int).Set(last),
+		keysPerPage: big.NewInt(int64(keysPerPage)),
+	}, nil
+}
+
+// BitcoinKeyspace returns the keyspace of Bitcoin pages, they list every valid private key
+// starting with seed 1
+func BitcoinKeyspace(keysPerPage int) (*Keyspace, error) {
+	return NewKeyspace(one, largestBitcoinSeed, keysPerPage)
+}
+
+// EthereumKeyspace returns the keyspace of Ethereum pages, they start with seed 0 and end with
+// the keys after the curve order that keys.lol lists on its last page
+func EthereumKeyspace(keysPerPage int) (*Keyspace, error) {
+	return NewKeyspace(new(big.Int), lastEthereumSeed, keysPerPage)
+}
+
+// KeysPerPage returns the number of keys on a full page
+func (k *Keyspace) KeysPerPage() int {
+	return int(k.keysPerPage.Int64())
+}
+
+// FirstSeed returns the seed of the first key on the first page
+func (k *Keyspace) FirstSeed() *big.Int {
+	return new(big.Int).Set(k.first)
+}
+
+// LastSeed returns the seed of the last key on the last page
+func (k *Keyspace) LastSeed() *big.Int {
+	return new(big.Int).Set(k.last)
+}
+
+// FirstPage returns the number of the first page, which is always 1
+func (k *Keyspace) FirstPage() *big.Int {
+	return big.NewInt(1)
+}
+
+// LastPage returns the number of the last page
+func (k *Keyspace) LastPage() *big.Int {
+	page, _ := k.Locate(k.last)
+
+	return page
+}
+
+// Page parses a page expression and checks that it is in the keyspace. A page expression is a
+// decimal or 0x hex page number, "first" or "last", with an optional offset like "last-10".
+func (k *Keyspace) Page(pageNumber string) (*big.Int, error) {
+	page, err := parsePage(pageNumber, k.FirstPage(), k.LastPage())
+	if err != nil {
+		return nil, err
+	}
+
+	if page.Cmp(k.FirstPage()) < 0 {
+		return nil, fmt.Errorf("%w: page %s is before the first page", ErrPageOutOfRange, pageNumber)
+	}
+
+	if page.Cmp(k.LastPage()) > 0 {
+		return nil, fmt.Errorf("%w: page %s is after the last page %s for %d keys per page", ErrPageOutOfRange, pageNumber, k.LastPage(), k.KeysPerPage())
+	}
+
+	return page, nil
+}
+
+// PageRange parses a page expression or a range of two page expressions like "100..110" or
+// "last-9..last", and returns its first and last page. Both ends are included.
+func (k *Keyspace) PageRange(pageRange string) (*big.Int, *big.Int, error) {
+	from, to := pageRange, pageRange
+
+	if i := strings.Index(pageRange, ".."); i >= 0 {
+		from, to = pageRange[:i], pageRange[i+2:]
+	}
+
+	fromPage, err := k.Page(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toPage, err := k.Page(to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if fromPage.Cmp(toPage) > 0 {
+		return nil, nil, fmt.Errorf("%w: range %q ends before it starts", ErrInvalidPage, pageRange)
+	}
+
+	return fromPage, toPage, nil
+}
+
+// Step returns the page that is steps pages after a page, or before it for negative steps
+func (k *Keyspace) Step(page *big.Int, steps *big.Int) (*big.Int, error) {
+	if page.Sign() <= 0 {
+		return nil, fmt.Errorf("%w %s", ErrInvalidPage, page)
+	}
+
+	stepped := new(big.Int).Add(page, steps)
+
+	if stepped.Cmp(k.FirstPage()) < 0 {
+		return nil, fmt.Errorf("%w: page %s is before the first page", ErrPageOutOfRange, stepped)
+	}
+
+	if stepped.Cmp(k.LastPage()) > 0 {
+		return nil, fmt.Errorf("%w: page %s is after the last page %s for %d keys per page", ErrPageOutOfRange, stepped, k.LastPage(), k.KeysPerPage())
+	}
+
+	return stepped, nil
+}
+
+// NextPage returns the page after a page
+func (k *Keyspace) NextPage(page *big.Int) (*big.Int, error) {
+	return k.Step(page, one)
+}
+
+// PreviousPage returns the page before a page
+func (k *Keyspace) PreviousPage(page *big.Int) (*big.Int, error) {
+	return k.Step(page, big.NewInt(-1))
+}
+
+// RandomPage returns a uniformly random page. Use crypto/rand.Reader for unpredictable pages,
+// or a math/rand.Rand with a fixed seed for reproducible ones.
+func (k *Keyspace) RandomPage(random io.Reader) (*big.Int, error) {
+	page, err := rand.Int(random, k.LastPage())
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Add(page, one), nil
+}
+
+// PageSeeds returns the first seed on a page and the number of keys on it. Only the last page
+// can have less than KeysPerPage keys, pages outside the keyspace have none.
+func (k *Keyspace) PageSeeds(page *big.Int) (*big.Int, int) {
+	if page.Sign() <= 0 || page.Cmp(k.LastPage()) > 0 {
+		return nil, 0
+	}
+
+	firstSeed, _ := k.Seed(page, 0)
+
+	remaining := new(big.Int).Sub(k.last, firstSeed)
+
+	if remaining.Cmp(k.keysPerPage) >= 0 {
+		return firstSeed, k.KeysPerPage()
+	}
+
+	return firstSeed, int(remaining.Int64()) + 1
+}
+
+// Locate returns the page that a seed is on and its index on that page
+func (k *Keyspace) Locate(seed *big.Int) (*big.Int, int) {
+	offset := new(big.Int).Sub(seed, k.first)
+
+	page, index := new(big.Int).DivMod(offset, k.keysPerPage, new(big.Int))
+
+	return page.Add(page, one), int(index.Int64())
+}
+
+// LocateKey returns the location of a seed that was searched for, ErrKeyOutOfRange if no page lists it
+func (k *Keyspace) LocateKey(seed *big.Int) (KeyLocation, error) {
+	if !k.Contains(seed) {
+		return KeyLocation{}, ErrKeyOutOfRange
+	}
+
+	page, index := k.Locate(seed)
+
+	return KeyLocation{Page: page, Index: index, Seed: new(big.Int).Set(seed)}, nil
+}
+
+// Contains reports whether a seed is listed on one of the pages
+func (k *Keyspace) Contains(seed *big.Int) bool {
+	return seed.Cmp(k.first) >= 0 && seed.Cmp(k.last) <= 0
+}
+
+// Seed returns the seed at an index on a page, it is the inverse of Locate
+func (k *Keyspace) Seed(page *big.Int, index int) (*big.Int, error) {
+	if page.Sign() <= 0 {
+		return nil, fmt.Errorf("%w %s", ErrInvalidPage, page)
+	}
+
+	if index < 0 || index >= k.KeysPerPage() {
+		return nil, fmt.Errorf("index %d is not on a page of %d keys", index, k.KeysPerPage())
+	}
+
+	seed := new(big.Int).Sub(page, one)
+	seed.Mul(seed, k.keysPerPage)
+	seed.Add(seed, big.NewInt(int64(index)))
+	seed.Add(seed, k.first)
+
+	if seed.Cmp(k.last) > 0 {
+		return nil, fmt.Errorf("%w: index %d of page %s is after the last key", ErrPageOutOfRange, index, page)
+	}
+
+	return seed, nil
+}
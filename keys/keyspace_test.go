@@ -0,0 +1,284 @@
+package keys
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestKeyspace_LastPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		keyspace    func(int) (*Keyspace, error)
+		keysPerPage int
+		wantPage    string
+		wantCount   int
+	}{
+		{"Bitcoin with 128 keys per page", BitcoinKeyspace, 128, "904625697166532776746648320380374280100293470930272690489102837043110636675", 64},
+		{"Ethereum with 128 keys per page", EthereumKeyspace, 128, "904625697166532776746648320380374280100293470930272690489102837043110636675", 96},
+		{"Bitcoin with 1 key per page", BitcoinKeyspace, 1, "115792089237316195423570985008687907852837564279074904382605163141518161494336", 1},
+		{"Ethereum with 20 keys per page", EthereumKeyspace, 20, "5789604461865809771178549250434395392641878213953745219130258157075908074719", 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyspace, err := tt.keyspace(tt.keysPerPage)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotPage := keyspace.LastPage().String(); gotPage != tt.wantPage {
+				t.Errorf("Expected: %v", tt.wantPage)
+				t.Errorf("Actual:   %v", gotPage)
+			}
+
+			if _, gotCount := keyspace.PageSeeds(keyspace.LastPage()); gotCount != tt.wantCount {
+				t.Errorf("Expected %d keys on the last page, got %d", tt.wantCount, gotCount)
+			}
+		})
+	}
+}
+
+func TestKeyspace_errors(t *testing.T) {
+	if _, err := BitcoinKeyspace(0); !errors.Is(err, ErrInvalidKeysPerPage) {
+		t.Errorf("Expected: %v", ErrInvalidKeysPerPage)
+		t.Errorf("Actual:   %v", err)
+	}
+
+	keyspace, _ := BitcoinKeyspace(128)
+
+	if _, err := keyspace.Seed(big.NewInt(0), 0); !errors.Is(err, ErrInvalidPage) {
+		t.Errorf("Expected: %v", ErrInvalidPage)
+		t.Errorf("Actual:   %v", err)
+	}
+
+	// the last page only has 64 keys
+	if _, err := keyspace.Seed(keyspace.LastPage(), 64); !errors.Is(err, ErrPageOutOfRange) {
+		t.Errorf("Expected: %v", ErrPageOutOfRange)
+		t.Errorf("Actual:   %v", err)
+	}
+
+	if _, err := keyspace.Page("904625697166532776746648320380374280100293470930272690489102837043110636676"); !errors.Is(err, ErrPageOutOfRange) {
+		t.Errorf("Expected: %v", ErrPageOutOfRange)
+		t.Errorf("Actual:   %v", err)
+	}
+}
+
+// randomSeed returns a uniformly random seed of the keyspace
+func randomSeed(r *rand.Rand, keyspace *Keyspace) *big.Int {
+	size := new(big.Int).Sub(keyspace.LastSeed(), keyspace.FirstSeed())
+	size.Add(size, one)
+
+	return new(big.Int).Add(keyspace.FirstSeed(), new(big.Int).Rand(r, size))
+}
+
+func TestKeyspace_roundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, newKeyspace := range []func(int) (*Keyspace, error){BitcoinKeyspace, EthereumKeyspace} {
+		for i := 0; i < 500; i++ {
+			keysPerPage := 1 + r.Intn(1000)
+
+			keyspace, err := newKeyspace(keysPerPage)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// include both ends of the keyspace
+			seeds := []*big.Int{keyspace.FirstSeed(), keyspace.LastSeed(), randomSeed(r, keyspace)}
+
+			for _, seed := range seeds {
+				page, index := keyspace.Locate(seed)
+
+				if index < 0 || index >= keysPerPage {
+					t.Fatalf("Index %d of seed %s is not on a page of %d keys", index, seed, keysPerPage)
+				}
+
+				if page.Sign() <= 0 || page.Cmp(keyspace.LastPage()) > 0 {
+					t.Fatalf("Page %s of seed %s is outside the keyspace", page, seed)
+				}
+
+				gotSeed, err := keyspace.Seed(page, index)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if gotSeed.Cmp(seed) != 0 {
+					t.Fatalf("Seed %s with %d keys per page maps to page %s index %d, which maps back to %s", seed, keysPerPage, page, index, gotSeed)
+				}
+
+				firstSeed, count := keyspace.PageSeeds(page)
+
+				if seed.Cmp(firstSeed) < 0 || new(big.Int).Sub(seed, firstSeed).Int64() >= int64(count) {
+					t.Fatalf("Seed %s is not in the %d seeds of page %s starting at %s", seed, count, page, firstSeed)
+				}
+			}
+		}
+	}
+}
+
+func TestKeyspace_Step(t *testing.T) {
+	keyspace, _ := EthereumKeyspace(20)
+	last := keyspace.LastPage()
+
+	tests := []struct {
+		name     string
+		page     *big.Int
+		steps    int64
+		wantPage *big.Int
+		wantErr  error
+	}{
+		{"It can step forward", big.NewInt(1), 5, big.NewInt(6), nil},
+		{"It can step back", big.NewInt(10), -9, big.NewInt(1), nil},
+		{"It can step to the last page", new(big.Int).Sub(last, big.NewInt(3)), 3, last, nil},
+		{"It can not step before the first page", big.NewInt(1), -1, nil, ErrPageOutOfRange},
+		{"It can not step after the last page", last, 1, nil, ErrPageOutOfRange},
+		{"It can not step from page 0", big.NewInt(0), 1, nil, ErrInvalidPage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, err := keyspace.Step(tt.page, big.NewInt(tt.steps))
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+
+			if tt.wantPage != nil && (gotPage == nil || gotPage.Cmp(tt.wantPage) != 0) {
+				t.Errorf("Expected: %v", tt.wantPage)
+				t.Errorf("Actual:   %v", gotPage)
+			}
+		})
+	}
+
+	if next, _ := keyspace.NextPage(big.NewInt(7)); next.Cmp(big.NewInt(8)) != 0 {
+		t.Errorf("Expected the next page of 7 to be 8, got %v", next)
+	}
+
+	if previous, _ := keyspace.PreviousPage(big.NewInt(7)); previous.Cmp(big.NewInt(6)) != 0 {
+		t.Errorf("Expected the previous page of 7 to be 6, got %v", previous)
+	}
+}
+
+func TestKeyspace_RandomPage(t *testing.T) {
+	for _, keysPerPage := range []int{1, 128, 1000} {
+		keyspace, _ := BitcoinKeyspace(keysPerPage)
+
+		first, err := keyspace.RandomPage(rand.New(rand.NewSource(42)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		again, _ := keyspace.RandomPage(rand.New(rand.NewSource(42)))
+		if first.Cmp(again) != 0 {
+			t.Errorf("Expected the same seed to give the same page, got %s and %s", first, again)
+		}
+
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 100; i++ {
+			page, _ := keyspace.RandomPage(r)
+
+			if page.Cmp(keyspace.FirstPage()) < 0 || page.Cmp(keyspace.LastPage()) > 0 {
+				t.Fatalf("Random page %s is outside the keyspace of %d keys per page", page, keysPerPage)
+			}
+		}
+	}
+
+	// a keyspace with a single page
+	keyspace, _ := NewKeyspace(one, big.NewInt(10), 10)
+	if page, _ := keyspace.RandomPage(rand.New(rand.NewSource(1))); page.Cmp(one) != 0 {
+		t.Errorf("Expected the only page, got %s", page)
+	}
+}
+
+func TestKeyspace_Page(t *testing.T) {
+	keyspace, _ := BitcoinKeyspace(128)
+	last := keyspace.LastPage()
+
+	tests := []struct {
+		name       string
+		expression string
+		wantPage   *big.Int
+		wantErr    error
+	}{
+		{"It can parse a decimal page", "42", big.NewInt(42), nil},
+		{"It can parse a decimal page with leading zeros", "010", big.NewInt(10), nil},
+		{"It can parse a hex page", "0x1F", big.NewInt(31), nil},
+		{"It can parse the first page", "first", big.NewInt(1), nil},
+		{"It can parse the last page", "last", last, nil},
+		{"It can parse an offset from the first page", "first+5", big.NewInt(6), nil},
+		{"It can parse an offset from the last page", "last-10", new(big.Int).Sub(last, big.NewInt(10)), nil},
+		{"It can parse a hex offset", "LAST-0x10", new(big.Int).Sub(last, big.NewInt(16)), nil},
+		{"It can parse an offset from a page", "0x10+1", big.NewInt(17), nil},
+		{"It rejects a negative page", "-1", nil, ErrInvalidPage},
+		{"It rejects page zero", "0x0", nil, ErrInvalidPage},
+		{"It rejects an offset without a number", "last-", nil, ErrInvalidPage},
+		{"It rejects an unknown name", "middle", nil, ErrInvalidPage},
+		{"It rejects a signed offset", "first+-1", nil, ErrInvalidPage},
+		{"It rejects hex without digits", "0x", nil, ErrInvalidPage},
+		{"It rejects a page before the first page", "first-1", nil, ErrPageOutOfRange},
+		{"It rejects a page after the last page", "last+1", nil, ErrPageOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, err := keyspace.Page(tt.expression)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+
+			if tt.wantPage != nil && (gotPage == nil || gotPage.Cmp(tt.wantPage) != 0) {
+				t.Errorf("Expected: %v", tt.wantPage)
+				t.Errorf("Actual:   %v", gotPage)
+			}
+		})
+	}
+}
+
+func TestKeyspace_PageRange(t *testing.T) {
+	keyspace, _ := EthereumKeyspace(20)
+	last := keyspace.LastPage()
+
+	tests := []struct {
+		name      string
+		pageRange string
+		wantFrom  *big.Int
+		wantTo    *big.Int
+		wantErr   error
+	}{
+		{"It can parse a single page", "7", big.NewInt(7), big.NewInt(7), nil},
+		{"It can parse a range", "100..110", big.NewInt(100), big.NewInt(110), nil},
+		{"It can parse a range of expressions", "last-2..last", new(big.Int).Sub(last, big.NewInt(2)), last, nil},
+		{"It can parse a range of one page", "0x10..16", big.NewInt(16), big.NewInt(16), nil},
+		{"It rejects a range that ends before it starts", "110..100", nil, nil, ErrInvalidPage},
+		{"It rejects a range without an end", "1..", nil, nil, ErrInvalidPage},
+		{"It rejects a range that ends after the last page", "last-1..last+1", nil, nil, ErrPageOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotFrom, gotTo, err := keyspace.PageRange(tt.pageRange)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+
+			if tt.wantFrom != nil && (gotFrom == nil || gotFrom.Cmp(tt.wantFrom) != 0 || gotTo.Cmp(tt.wantTo) != 0) {
+				t.Errorf("Expected: %v..%v", tt.wantFrom, tt.wantTo)
+				t.Errorf("Actual:   %v..%v", gotFrom, gotTo)
+			}
+		})
+	}
+}
+
+func mustPage(t *testing.T, keyspace *Keyspace, pageNumber string) *big.Int {
+	t.Helper()
+
+	page, err := keyspace.Page(pageNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return page
+}
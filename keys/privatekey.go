@@ -0,0 +1,184 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// KeyFormat is the format that a private key was given in
+type KeyFormat string
+
+// The formats that ParsePrivateKey detects
+const (
+	KeyFormatHex                  KeyFormat = "hex"
+	KeyFormatDecimal              KeyFormat = "decimal"
+	KeyFormatWIF                  KeyFormat = "WIF"
+	KeyFormatCompressedWIF        KeyFormat = "compressed WIF"
+	KeyFormatTestnetWIF           KeyFormat = "testnet WIF"
+	KeyFormatCompressedTestnetWIF KeyFormat = "compressed testnet WIF"
+	KeyFormatMini                 KeyFormat = "mini private key"
+)
+
+// PrivateKey is a private key that was parsed by ParsePrivateKey
+type PrivateKey struct {
+	Seed   *big.Int
+	Format KeyFormat
+}
+
+// decimalPrefix marks a decimal private key, a number without it is hex
+const decimalPrefix = "dec:"
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// ParsePrivateKey parses a private key and detects its format:
+//   - hex: up to 64 hex characters, with or without 0x
+//   - decimal: the decimal value of the seed after dec:
+//   - WIF: uncompressed or compressed, for mainnet or for the test networks
+//   - mini private key: the 22, 26 or 30 character Casascius keys that start with S
+//
+// A number of only digits is hex, as eth-search always read it, decimal needs the dec: prefix.
+// The seed is not checked against a keyspace, and a WIF is not checked against a network, see
+// ParseNetworkPrivateKey.
+func ParsePrivateKey(input string) (PrivateKey, error) {
+	input = strings.TrimSpace(input)
+
+	if strings.HasPrefix(input, "0x") || strings.HasPrefix(input, "0X") {
+		return parseHexKey(input, input[2:])
+	}
+
+	if strings.HasPrefix(input, decimalPrefix) {
+		return parseDecimalKey(input, input[len(decimalPrefix):])
+	}
+
+	if len(input) == 64 && isHex(input) || input != "" && strings.Trim(input, "0123456789") == "" {
+		return parseHexKey(input, input)
+	}
+
+	if isMiniKey(input) {
+		hash := sha256.Sum256([]byte(input))
+
+		return PrivateKey{Seed: new(big.Int).SetBytes(hash[:]), Format: KeyFormatMini}, nil
+	}
+
+	if wif, err := btcutil.DecodeWIF(input); err == nil {
+		return parseWIF(input, wif)
+	}
+
+	if isHex(input) {
+		return parseHexKey(input, input)
+	}
+
+	return PrivateKey{}, fmt.Errorf("%w: %q is not a hex, decimal, WIF or mini private key", ErrMalformedKey, input)
+}
+
+func parseHexKey(input, hex string) (PrivateKey, error) {
+	if hex == "" || len(hex) > 64 || !isHex(hex) {
+		return PrivateKey{}, fmt.Errorf("%w: invalid hex private key %q", ErrMalformedKey, input)
+	}
+
+	seed, _ := new(big.Int).SetString(hex, 16)
+
+	return PrivateKey{Seed: seed, Format: KeyFormatHex}, nil
+}
+
+func parseDecimalKey(input, decimal string) (PrivateKey, error) {
+	if decimal == "" || strings.Trim(decimal, "0123456789") != "" {
+		return PrivateKey{}, fmt.Errorf("%w: invalid decimal private key %q", ErrMalformedKey, input)
+	}
+
+	seed, _ := new(big.Int).SetString(decimal, 10)
+
+	return PrivateKey{Seed: seed, Format: KeyFormatDecimal}, nil
+}
+
+func parseWIF(input string, wif *btcutil.WIF) (PrivateKey, error) {
+	var format KeyFormat
+
+	switch {
+	case wif.IsForNet(&chaincfg.MainNetParams) && wif.CompressPubKey:
+		format = KeyFormatCompressedWIF
+	case wif.IsForNet(&chaincfg.MainNetParams):
+		format = KeyFormatWIF
+	case wif.IsForNet(&chaincfg.TestNet3Params) && wif.CompressPubKey:
+		format = KeyFormatCompressedTestnetWIF
+	case wif.IsForNet(&chaincfg.TestNet3Params):
+		format = KeyFormatTestnetWIF
+	default:
+		return PrivateKey{}, fmt.Errorf("%w: WIF %q is not for a Bitcoin network", ErrMalformedKey, input)
+	}
+
+	return PrivateKey{Seed: wif.PrivKey.D, Format: format}, nil
+}
+
+// ParseNetworkPrivateKey parses a private key like ParsePrivateKey, but a WIF has to be for the
+// network of params. WIFs of other coins that use the keys of Bitcoin are accepted for their own
+// networks.
+func ParseNetworkPrivateKey(input string, params *chaincfg.Params) (PrivateKey, error) {
+	key, err := ParsePrivateKey(input)
+	if err == nil && !key.Format.isWIF() {
+		return key, nil
+	}
+
+	trimmed := strings.TrimSpace(input)
+
+	wif, wifErr := btcutil.DecodeWIF(trimmed)
+	if wifErr != nil {
+		return key, err
+	}
+
+	if !wif.IsForNet(params) {
+		return PrivateKey{}, fmt.Errorf("%w: WIF %q is not for %s", ErrMalformedKey, trimmed, params.Name)
+	}
+
+	if err == nil {
+		return key, nil
+	}
+
+	if wif.CompressPubKey {
+		return PrivateKey{Seed: wif.PrivKey.D, Format: KeyFormatCompressedWIF}, nil
+	}
+
+	return PrivateKey{Seed: wif.PrivKey.D, Format: KeyFormatWIF}, nil
+}
+
+func (f KeyFormat) isWIF() bool {
+	switch f {
+	case KeyFormatWIF, KeyFormatCompressedWIF, KeyFormatTestnetWIF, KeyFormatCompressedTestnetWIF:
+		return true
+	}
+
+	return false
+}
+
+// isMiniKey reports whether s is a Casascius mini private key: a base58 string of 22, 26 or 30
+// characters that starts with S, and whose SHA-256 with a "?" appended starts with a zero byte
+func isMiniKey(s string) bool {
+	if len(s) != 22 && len(s) != 26 && len(s) != 30 || s[0] != 'S' {
+		return false
+	}
+
+	for _, c := range s {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return false
+		}
+	}
+
+	check := sha256.Sum256([]byte(s + "?"))
+
+	return check[0] == 0
+}
+
+func isHex(s string) bool {
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+
+	return s != ""
+}
@@ -0,0 +1,104 @@
+package keys
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestParsePrivateKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantSeed   string
+		wantFormat KeyFormat
+	}{
+		{"It can parse 64 hex characters", "0000000000000000000000000000000000000000000000000000000000000010", "16", KeyFormatHex},
+		{"It can parse hex with 0x", "0x1F", "31", KeyFormatHex},
+		{"It can parse short hex with a letter", "ff", "255", KeyFormatHex},
+		{"It parses a number of only digits as hex", "10", "16", KeyFormatHex},
+		{"It parses a number with leading zeros as hex", "0129", "297", KeyFormatHex},
+		{"It can parse a decimal seed", "dec:129", "129", KeyFormatDecimal},
+		{"It can parse a decimal seed after the curve order", "dec:115792089237316195423570985008687907852837564279074904382605163141518161494337", "115792089237316195423570985008687907852837564279074904382605163141518161494337", KeyFormatDecimal},
+		{"It can parse a WIF", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf", "1", KeyFormatWIF},
+		{"It can parse a compressed WIF", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "1", KeyFormatCompressedWIF},
+		{"It can parse a testnet WIF", "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgww7vXtT", "2", KeyFormatTestnetWIF},
+		{"It can parse a compressed testnet WIF", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87K7XCyj5v", "2", KeyFormatCompressedTestnetWIF},
+		{"It can parse a mini private key", "S6c56bnXQiBjk9mqSYE7ykVQ7NzrRy", "34592368842595783461480169529335747692719505392594229791591388717055904041387", KeyFormatMini},
+		{"It can parse a 22 character mini private key", "SZEfg4eYxCJoqzumUqP34g", "69152777466164470431518107951447492398159049048344551807448325139786824786720", KeyFormatMini},
+		{"It ignores surrounding whitespace", " dec:42\n", "42", KeyFormatDecimal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if key.Seed.String() != tt.wantSeed || key.Format != tt.wantFormat {
+				t.Errorf("Expected: %v %v", tt.wantFormat, tt.wantSeed)
+				t.Errorf("Actual:   %v %v", key.Format, key.Seed)
+			}
+		})
+	}
+}
+
+func TestParsePrivateKey_errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"It rejects an empty key", ""},
+		{"It rejects 0x without digits", "0x"},
+		{"It rejects more than 64 hex characters", "0x0000000000000000000000000000000000000000000000000000000000000000f"},
+		{"It rejects a WIF with a wrong checksum", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDg"},
+		{"It rejects a WIF of another coin", "T33ydQRKp4FCW5LCLLUB7deioUMoveiwekdwUwyfRDeGZm76aUjV"},
+		{"It rejects a mini private key with a wrong check", "S6c56bnXQiBjk9mqSYE7ykVQ7NzrRz"},
+		{"It rejects a negative seed", "-1"},
+		{"It rejects dec: without digits", "dec:"},
+		{"It rejects a decimal seed with hex digits", "dec:ff"},
+		{"It rejects a number of more than 64 digits", "115792089237316195423570985008687907852837564279074904382605163141518161494337"},
+		{"It rejects anything else", "not-a-key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePrivateKey(tt.input); !errors.Is(err, ErrMalformedKey) {
+				t.Errorf("Expected: %v", ErrMalformedKey)
+				t.Errorf("Actual:   %v", err)
+			}
+		})
+	}
+}
+
+func TestParseNetworkPrivateKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		params     *chaincfg.Params
+		wantFormat KeyFormat
+		wantErr    error
+	}{
+		{"It can parse a mainnet WIF on mainnet", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", &chaincfg.MainNetParams, KeyFormatCompressedWIF, nil},
+		{"It can parse a testnet WIF on testnet", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87K7XCyj5v", &chaincfg.TestNet3Params, KeyFormatCompressedTestnetWIF, nil},
+		{"It can parse a decimal seed on every network", "dec:2", &chaincfg.TestNet3Params, KeyFormatDecimal, nil},
+		{"It rejects a mainnet WIF on testnet", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", &chaincfg.TestNet3Params, "", ErrMalformedKey},
+		{"It rejects a testnet WIF on mainnet", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87K7XCyj5v", &chaincfg.MainNetParams, "", ErrMalformedKey},
+		{"It rejects a WIF of another coin", "T33ydQRKp4FCW5LCLLUB7deioUMoveiwekdwUwyfRDeGZm76aUjV", &chaincfg.MainNetParams, "", ErrMalformedKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNetworkPrivateKey(tt.input, tt.params)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected: %v", tt.wantErr)
+				t.Errorf("Actual:   %v", err)
+			}
+
+			if err == nil && got.Format != tt.wantFormat {
+				t.Errorf("Expected: %v", tt.wantFormat)
+				t.Errorf("Actual:   %v", got.Format)
+			}
+		})
+	}
+}
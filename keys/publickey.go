@@ -0,0 +1,74 @@
+package keys
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PublicKey is the public key of a private key, its fields are empty when the seed is invalid
+type PublicKey struct {
+	// Compressed is the hex encoded 33-byte SEC1 public key
+	Compressed string
+	// Uncompressed is the hex encoded 65-byte SEC1 public key
+	Uncompressed string
+	// Hash160 is the hex encoded RIPEMD-160 of the SHA-256 of the compressed public key, the
+	// hash of the compressed P2PKH and native SegWit addresses
+	Hash160 string
+	// Keccak is the hex encoded Keccak-256 of the uncompressed public key without its 0x04 prefix,
+	// the Ethereum address is its last 20 bytes
+	Keccak string
+}
+
+// PublicKeyColumns returns the names of the fields of a public key in a row
+func PublicKeyColumns() []string {
+	return []string{"publicKeyCompressed", "publicKeyUncompressed", "hash160", "keccak"}
+}
+
+// Values returns the fields of the public key in the order of PublicKeyColumns
+func (k PublicKey) Values() []string {
+	return []string{k.Compressed, k.Uncompressed, k.Hash160, k.Keccak}
+}
+
+// GeneratePublicKeys returns the public keys of the seeds on a page of any keyspace, in the same
+// order as the keys that the generators return for that page
+func GeneratePublicKeys(keyspace *Keyspace, pageNumber string) ([]PublicKey, error) {
+	page, err := keyspace.Page(pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	firstSeed, count := keyspace.PageSeeds(page)
+
+	var publicKeys []PublicKey
+
+	for i := 0; i < count; i++ {
+		publicKeys = append(publicKeys, publicKeyFromSeed(firstSeed))
+
+		firstSeed.Add(firstSeed, one)
+	}
+
+	return publicKeys, nil
+}
+
+func publicKeyFromSeed(seed *big.Int) PublicKey {
+	scalar, status := SeedScalar(seed)
+	if status == SeedInvalid {
+		return PublicKey{}
+	}
+
+	_, public := btcec.PrivKeyFromBytes(btcec.S256(), seedBytes(scalar))
+
+	compressed := public.SerializeCompressed()
+	uncompressed := public.SerializeUncompressed()
+
+	return PublicKey{
+		Compressed:   hex.EncodeToString(compressed),
+		Uncompressed: hex.EncodeToString(uncompressed),
+		Hash160:      hex.EncodeToString(btcutil.Hash160(compressed)),
+		Keccak:       hex.EncodeToString(crypto.Keccak256(uncompressed[1:])),
+	}
+}
@@ -0,0 +1,70 @@
+package keys
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestGeneratePublicKeys(t *testing.T) {
+	keyspace, _ := EthereumKeyspace(2)
+
+	publicKeys, err := GeneratePublicKeys(keyspace, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []PublicKey{
+		{},
+		{
+			Compressed:   "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			Uncompressed: "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+			Hash160:      "751e76e8199196d454941c45d1b3a323f1433bd6",
+			Keccak:       "c0a6c424ac7157ae408398df7e5f4552091a69125d5dfcb7b8c2659029395bdf",
+		},
+	}
+
+	if !reflect.DeepEqual(publicKeys, want) {
+		t.Errorf("Expected: %#v", want)
+		t.Errorf("Actual:   %#v", publicKeys)
+	}
+}
+
+// TestGeneratePublicKeys_addresses checks the digests against the addresses of the generators
+func TestGeneratePublicKeys_addresses(t *testing.T) {
+	for _, pageNumber := range []string{"first+3", "0x7fffffffffffffffffffffffffffffff", "last"} {
+		bitcoinKeyspace, _ := BitcoinKeyspace(16)
+		bitcoinKeys, _ := GenerateBitcoinKeys(pageNumber, 16, &chaincfg.MainNetParams)
+		bitcoinPublicKeys, _ := GeneratePublicKeys(bitcoinKeyspace, pageNumber)
+
+		for i, key := range bitcoinKeys {
+			if want := "0014" + bitcoinPublicKeys[i].Hash160; key.RedeemScript != want {
+				t.Errorf("Expected: %v", want)
+				t.Errorf("Actual:   %v", key.RedeemScript)
+			}
+		}
+
+		ethereumKeyspace, _ := EthereumKeyspace(16)
+		ethereumKeys, _ := GenerateEthereumKeys(pageNumber, 16)
+		ethereumPublicKeys, _ := GeneratePublicKeys(ethereumKeyspace, pageNumber)
+
+		for i, key := range ethereumKeys {
+			if got := ethereumPublicKeys[i].Keccak; !strings.HasSuffix(got, strings.ToLower(key.Public[2:])) {
+				t.Errorf("Expected the keccak digest to end with: %v", key.Public)
+				t.Errorf("Actual:   %v", got)
+			}
+		}
+	}
+}
+
+func TestGeneratePublicKeys_errors(t *testing.T) {
+	keyspace, _ := BitcoinKeyspace(128)
+
+	if _, err := GeneratePublicKeys(keyspace, "last+1"); !errors.Is(err, ErrPageOutOfRange) {
+		t.Errorf("Expected: %v", ErrPageOutOfRange)
+		t.Errorf("Actual:   %v", err)
+	}
+}
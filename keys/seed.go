@@ -0,0 +1,50 @@
+package keys
+
+import (
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// SeedStatus tells how the keys of a row are derived from its seed
+type SeedStatus string
+
+// The statuses that SeedScalar returns
+const (
+	// SeedValid is a seed from 1 to n-1, it is the private key itself
+	SeedValid SeedStatus = "valid"
+	// SeedWrapped is a seed of n or more, its addresses are those of the seed modulo n
+	SeedWrapped SeedStatus = "wrapped"
+	// SeedInvalid is a seed that is a multiple of n, it has no public key and no addresses
+	SeedInvalid SeedStatus = "invalid"
+)
+
+// curveOrder is n, the order of the secp256k1 group
+var curveOrder = btcec.S256().N
+
+// SeedScalar returns the scalar that the public key of a seed is derived from, the seed modulo
+// the curve order, and the status of the seed. The scalar of an invalid seed is zero.
+//
+// Every page uses this policy: the private key columns encode the seed as it is listed, the
+// public key and address columns encode the scalar, and are empty when the seed is invalid.
+func SeedScalar(seed *big.Int) (*big.Int, SeedStatus) {
+	scalar := new(big.Int).Mod(seed, curveOrder)
+
+	switch {
+	case scalar.Sign() == 0:
+		return scalar, SeedInvalid
+	case seed.Cmp(curveOrder) >= 0:
+		return scalar, SeedWrapped
+	default:
+		return scalar, SeedValid
+	}
+}
+
+// seedBytes returns a seed left-padded to 32 bytes
+func seedBytes(seed *big.Int) []byte {
+	var padded [32]byte
+
+	seed.FillBytes(padded[:])
+
+	return padded[:]
+}
@@ -0,0 +1,111 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+func TestSeedScalar(t *testing.T) {
+	offset := func(k int64) *big.Int {
+		return new(big.Int).Add(curveOrder, big.NewInt(k))
+	}
+
+	tests := []struct {
+		name       string
+		seed       *big.Int
+		wantScalar *big.Int
+		wantStatus SeedStatus
+	}{
+		{"It rejects seed 0", big.NewInt(0), big.NewInt(0), SeedInvalid},
+		{"It keeps seed 1", big.NewInt(1), big.NewInt(1), SeedValid},
+		{"It keeps the largest valid seed", offset(-1), offset(-1), SeedValid},
+		{"It rejects the curve order", offset(0), big.NewInt(0), SeedInvalid},
+		{"It wraps the seed after the curve order", offset(1), big.NewInt(1), SeedWrapped},
+		{"It wraps the last Ethereum seed", offset(30), big.NewInt(30), SeedWrapped},
+		{"It rejects twice the curve order", new(big.Int).Add(curveOrder, curveOrder), big.NewInt(0), SeedInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotScalar, gotStatus := SeedScalar(tt.seed)
+
+			if gotScalar.Cmp(tt.wantScalar) != 0 || gotStatus != tt.wantStatus {
+				t.Errorf("Expected: %v %v", tt.wantScalar, tt.wantStatus)
+				t.Errorf("Actual:   %v %v", gotScalar, gotStatus)
+			}
+		})
+	}
+}
+
+func TestBitcoinKeyFromSeed_wrapped(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	wrapped := bitcoinKeyFromSeed(new(big.Int).Add(curveOrder, one), params)
+	valid := bitcoinKeyFromSeed(one, params)
+
+	if wrapped.Compressed != valid.Compressed || wrapped.Taproot != valid.Taproot {
+		t.Errorf("Expected: %v %v", valid.Compressed, valid.Taproot)
+		t.Errorf("Actual:   %v %v", wrapped.Compressed, wrapped.Taproot)
+	}
+
+	// the WIF encodes the listed seed, so that searching for it finds the wrapped row
+	wif, err := btcutil.DecodeWIF(wrapped.PrivateCompressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := new(big.Int).Add(curveOrder, one); wif.PrivKey.D.Cmp(want) != 0 {
+		t.Errorf("Expected: %v", want)
+		t.Errorf("Actual:   %v", wif.PrivKey.D)
+	}
+
+	invalid := bitcoinKeyFromSeed(curveOrder, params)
+
+	if invalid.Private == "" || invalid.Compressed != "" || invalid.Taproot != "" {
+		t.Errorf("Expected a row with WIFs and without addresses")
+		t.Errorf("Actual:   %#v", invalid)
+	}
+}
+
+// TestKeyspaceEnds checks the first and the last key of both chains for page sizes that do and
+// do not divide the keyspace
+func TestKeyspaceEnds(t *testing.T) {
+	for _, keysPerPage := range []int{1, 2, 3, 7, 20, 31, 32, 100, 128, 1000} {
+		t.Run(fmt.Sprintf("%d keys per page", keysPerPage), func(t *testing.T) {
+			bitcoinKeyspace, _ := BitcoinKeyspace(keysPerPage)
+			bitcoinFirst, _ := GenerateBitcoinKeys("first", keysPerPage, &chaincfg.MainNetParams)
+			bitcoinLast, _ := GenerateBitcoinKeys("last", keysPerPage, &chaincfg.MainNetParams)
+
+			if got, want := bitcoinFirst[0], bitcoinKeyFromSeed(one, &chaincfg.MainNetParams); got != want || got.Compressed == "" {
+				t.Errorf("Expected: %#v", want)
+				t.Errorf("Actual:   %#v", got)
+			}
+
+			if got, want := bitcoinLast[len(bitcoinLast)-1], bitcoinKeyFromSeed(largestBitcoinSeed, &chaincfg.MainNetParams); got != want || got.Compressed == "" {
+				t.Errorf("Expected: %#v", want)
+				t.Errorf("Actual:   %#v", got)
+			}
+
+			if _, status := SeedScalar(bitcoinKeyspace.LastSeed()); status != SeedValid {
+				t.Errorf("Expected the last Bitcoin seed to be %v, got %v", SeedValid, status)
+			}
+
+			ethereumFirst, _ := GenerateEthereumKeys("first", keysPerPage)
+			ethereumLast, _ := GenerateEthereumKeys("last", keysPerPage)
+
+			if got := ethereumFirst[0]; got.Private != fmt.Sprintf("%064x", 0) || got.Public != "" {
+				t.Errorf("Expected seed 0 to be invalid")
+				t.Errorf("Actual:   %#v", got)
+			}
+
+			// the last key wraps around to seed 30
+			if got, want := ethereumLast[len(ethereumLast)-1], ethereumKeyFromSeed(big.NewInt(30)); got.Public != want.Public || got.Private != fmt.Sprintf("%064x", lastEthereumSeed) {
+				t.Errorf("Expected: %v", want.Public)
+				t.Errorf("Actual:   %#v", got)
+			}
+		})
+	}
+}
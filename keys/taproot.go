@@ -0,0 +1,129 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// bech32mConst is the checksum constant from BIP350, bech32 (BIP173) uses 1
+const bech32mConst = 0x2bc830a3
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var bech32Generator = []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// xOnlyPubKey returns the 32 byte BIP340 x-only encoding of a public key
+func xOnlyPubKey(public *btcec.PublicKey) []byte {
+	var padded [32]byte
+
+	copy(padded[32-len(public.X.Bytes()):], public.X.Bytes())
+
+	return padded[:]
+}
+
+// taggedHash is the BIP340 tagged hash: sha256(sha256(tag) || sha256(tag) || msg)
+func taggedHash(tag string, msg []byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	h.Write(msg)
+
+	return h.Sum(nil)
+}
+
+// taprootOutputKey tweaks the internal key the way BIP86 does for a key-path-only output,
+// Q = lift_x(P) + hash_TapTweak(P)*G, and returns the x-only encoding of Q
+func taprootOutputKey(public *btcec.PublicKey) []byte {
+	curve := btcec.S256()
+
+	internalKey := xOnlyPubKey(public)
+
+	// lift_x always picks the point with an even y coordinate
+	y := public.Y
+	if y.Bit(0) == 1 {
+		y = new(big.Int).Sub(curve.P, y)
+	}
+
+	tweakX, tweakY := curve.ScalarBaseMult(taggedHash("TapTweak", internalKey))
+
+	outputX, _ := curve.Add(public.X, y, tweakX, tweakY)
+
+	var padded [32]byte
+
+	copy(padded[32-len(outputX.Bytes()):], outputX.Bytes())
+
+	return padded[:]
+}
+
+// encodeTaprootAddress encodes a witness version 1 program as a bech32m address,
+// btcutil only knows about the bech32 checksum used by version 0 programs
+func encodeTaprootAddress(hrp string, program []byte) (string, error) {
+	converted, err := bech32.ConvertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32m(hrp, append([]byte{1}, converted...)), nil
+}
+
+// encodeBech32m encodes 5 bit data groups with the BIP350 bech32m checksum
+func encodeBech32m(hrp string, data []byte) string {
+	values := append(bech32HrpExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+
+	polymod := bech32Polymod(values) ^ bech32mConst
+
+	var sb strings.Builder
+
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+
+	for _, b := range data {
+		sb.WriteByte(bech32Charset[b])
+	}
+
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+
+	return sb.String()
+}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+
+	return chk
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+
+	expanded = append(expanded, 0)
+
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+
+	return expanded
+}
@@ -0,0 +1,82 @@
+package keys
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// tronAddressPrefix is the version byte of Tron mainnet addresses, it is the T of base58 addresses
+const tronAddressPrefix = 0x41
+
+// TronKey is one row of a Tron page. A Tron address is the Ethereum address of the key with the
+// Tron version byte, Tron pages list the seeds of Ethereum pages.
+type TronKey struct {
+	// Private is the hex private key, the same as on Ethereum pages
+	Private string
+	// Public is the base58check address (T…)
+	Public string
+	// PublicHex is the hex address with its 41 version byte
+	PublicHex string
+}
+
+// Values returns the fields of the key in the order of the columns of Tron
+func (k TronKey) Values() []string {
+	return []string{k.Private, k.Public, k.PublicHex}
+}
+
+// Tron is the coin of Tron pages
+var Tron Coin = tronCoin{}
+
+type tronCoin struct{}
+
+func (tronCoin) Name() string {
+	return "trx"
+}
+
+func (tronCoin) Title() string {
+	return "Tron"
+}
+
+func (tronCoin) Networks() []string {
+	return nil
+}
+
+func (c tronCoin) Network(name string) (Coin, error) {
+	return noNetworks(c, name)
+}
+
+func (tronCoin) Keyspace(keysPerPage int) (*Keyspace, error) {
+	return EthereumKeyspace(keysPerPage)
+}
+
+func (tronCoin) Columns() []string {
+	return []string{"private", "public", "publicHex"}
+}
+
+func (tronCoin) Row(seed *big.Int) []string {
+	return tronKeyFromSeed(seed).Values()
+}
+
+func (tronCoin) ParsePrivateKey(input string) (PrivateKey, error) {
+	return ParsePrivateKey(input)
+}
+
+func tronKeyFromSeed(seed *big.Int) TronKey {
+	ethereumKey := ethereumKeyFromSeed(seed)
+
+	key := TronKey{Private: ethereumKey.Private}
+
+	if ethereumKey.Public == "" {
+		return key
+	}
+
+	address := common.HexToAddress(ethereumKey.Public)
+
+	key.Public = base58.CheckEncode(address.Bytes(), tronAddressPrefix)
+	key.PublicHex = hex.EncodeToString(append([]byte{tronAddressPrefix}, address.Bytes()...))
+
+	return key
+}
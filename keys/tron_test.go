@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestTron_Row(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    *big.Int
+		wantKey TronKey
+	}{
+		{
+			"It rejects seed 0",
+			big.NewInt(0),
+			TronKey{Private: "0000000000000000000000000000000000000000000000000000000000000000"},
+		},
+		{
+			"It can generate the Tron key of seed 1",
+			big.NewInt(1),
+			TronKey{Private: "0000000000000000000000000000000000000000000000000000000000000001", Public: "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC", PublicHex: "417e5f4552091a69125d5dfcb7b8c2659029395bdf"},
+		},
+		{
+			"It can generate the Tron key of seed 2",
+			big.NewInt(2),
+			TronKey{Private: "0000000000000000000000000000000000000000000000000000000000000002", Public: "TDvSsdrNM5eeXNL3czpa6AxLDHZA9nwe9K", PublicHex: "412b5ad5c4795c026514f8317c7a215e218dccd6cf"},
+		},
+		{
+			"It can generate the Tron key of seed 3",
+			big.NewInt(3),
+			TronKey{Private: "0000000000000000000000000000000000000000000000000000000000000003", Public: "TKTX96CBxr5kvhjsDHcqoiPWZageGxoTW3", PublicHex: "416813eb9362372eef6200f3b1dbc3f819671cba69"},
+		},
+		{
+			"It wraps the seed after the curve order",
+			new(big.Int).Add(curveOrder, one),
+			TronKey{Private: "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364142", Public: "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC", PublicHex: "417e5f4552091a69125d5dfcb7b8c2659029395bdf"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tron.Row(tt.seed); !reflect.DeepEqual(got, tt.wantKey.Values()) {
+				t.Errorf("Expected: %v", tt.wantKey.Values())
+				t.Errorf("Actual:   %v", got)
+			}
+		})
+	}
+}
+
+// TestTron_sameSeeds checks that Tron pages have the private keys of the Ethereum page with the
+// same number
+func TestTron_sameSeeds(t *testing.T) {
+	tronRows, _ := GenerateRows(Tron, "last", 20)
+	ethereumRows, _ := GenerateRows(Ethereum, "last", 20)
+
+	if len(tronRows) != len(ethereumRows) {
+		t.Fatalf("Expected %d rows, got %d", len(ethereumRows), len(tronRows))
+	}
+
+	for i, row := range tronRows {
+		if row[0] != ethereumRows[i][0] {
+			t.Errorf("Expected: %v", ethereumRows[i][0])
+			t.Errorf("Actual:   %v", row[0])
+		}
+	}
+}
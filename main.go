@@ -1,404 +1,142 @@
 package main
 
 import (
+	"errors"
 	"fmt"
-	"log"
+	"io"
 	"math/big"
 	"os"
-	"os/signal"
-	"strconv"
-	"strings"
-	"sync"
-	"syscall"
-	"time"
-)
-
-type bruteFunc func(id int, start string, checker Checker, status chan PrinterData, writer func(string))
-
-func main() {
-	coin := os.Args[1]
 
-	keysPerPage := 128
-	wait := false
+	"github.com/leporel/keys-generator/keys"
+)
 
-	switch coin {
-	case "btc":
-		printBitcoinKeys(os.Args[2], keysPerPage)
-	case "btc-search":
-		printBtcWifSearch(os.Args[2], keysPerPage)
-	case "eth":
-		printEthereumKeys(os.Args[2], keysPerPage)
-	case "eth-search":
-		printEthPrivateKeySearch(os.Args[2], keysPerPage)
-	case "btc-brute":
-		bruteKeys(os.Args[2], 50, nil, "", "./btc_output.txt", btcWorker)
-	case "bsc-brute":
-		var apiKeys []string
-		var start = ""
-		if len(os.Args) > 3 {
-			apiKeys = strings.Split(os.Args[3], ",")
-		}
-		rate := 290
-		if len(apiKeys) == 0 {
-			log.Fatal("api key not provided")
-		}
-		if len(os.Args) > 4 {
-			start = os.Args[4]
-		}
-		bruteKeys(os.Args[2], rate, apiKeys, start, "./bsc_output.txt", bscWorker)
+// Exit codes, so that scripts can tell failures apart
+const (
+	exitError          = 1
+	exitUsage          = 2
+	exitInvalidPage    = 3
+	exitPageOutOfRange = 4
+	exitMalformedKey   = 5
+	exitKeyOutOfRange  = 6
+)
 
-		wait = true
-	case "eth-brute":
-		var apiKeys []string
-		var start = ""
-		if len(os.Args) > 3 {
-			apiKeys = strings.Split(os.Args[3], ",")
-		}
-		rate := 270
-		if len(apiKeys) == 0 {
-			apiKeys = []string{"YourApiKeyToken"}
-			rate = 10
-		}
-		if len(os.Args) > 4 {
-			start = os.Args[4]
-		}
-		bruteKeys(os.Args[2], rate, apiKeys, start, "./eth_output.txt", ethWorker)
+// defaultKeysPerPage is the page size of keys.lol
+const defaultKeysPerPage = 128
 
-		wait = true
-	default:
-		log.Fatal("Invalid coin type")
-	}
+// maxKeysPerPage keeps a single command or request from generating an unbounded number of keys
+const maxKeysPerPage = 1024
 
-	if wait {
-		c := make(chan os.Signal, 1)
-		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-		<-c
-		os.Exit(1)
-	}
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
 }
 
-func printBitcoinKeys(pageNumber string, keysPerPage int) {
-	bitcoinKeys := generateBitcoinKeys(pageNumber, keysPerPage)
-
-	length := len(bitcoinKeys)
-
-	for i, key := range bitcoinKeys {
-		fmt.Printf("%v", key)
-
-		if i != length-1 {
-			fmt.Print("\n")
-		}
+// printKeys prints a page or a range of pages of a coin
+func printKeys(w io.Writer, coin keys.Coin, pageRange string, keysPerPage int, out output) error {
+	keyspace, err := coin.Keyspace(keysPerPage)
+	if err != nil {
+		return err
 	}
-}
-
-func printBtcWifSearch(wif string, keysPerPage int) {
-	pageNumber := findBtcWifPage(wif, keysPerPage)
 
-	fmt.Printf("%v", pageNumber)
+	return writePages(w, out, keyspace, pageRange, coinRows(coin, keysPerPage))
 }
 
-func printEthereumKeys(pageNumber string, keysPerPage int) {
-	ethereumKeys := generateEthereumKeys(pageNumber, keysPerPage)
-
-	length := len(ethereumKeys)
+// coinRows returns the function that generates the fields of the keys of a page of a coin
+func coinRows(coin keys.Coin, keysPerPage int) func(pageNumber string) ([][]field, error) {
+	columns := coin.Columns()
 
-	for i, key := range ethereumKeys {
-		fmt.Printf("%v", key)
-
-		if i != length-1 {
-			fmt.Print("\n")
+	return func(pageNumber string) ([][]field, error) {
+		coinRows, err := keys.GenerateRows(coin, pageNumber, keysPerPage)
+		if err != nil {
+			return nil, err
 		}
-	}
-}
 
-func printEthPrivateKeySearch(privateKey string, keysPerPage int) {
-	pageNumber := findEthPrivateKeyPage(privateKey, keysPerPage)
+		rows := make([][]field, 0, len(coinRows))
+		for _, values := range coinRows {
+			rows = append(rows, namedFields(columns, values))
+		}
 
-	fmt.Printf("%v", pageNumber)
+		return rows, nil
+	}
 }
 
-func bruteKeys(workers string, limit int, apiKeys []string, start string, outFile string, brute bruteFunc) {
-	checker := NewChecker(limit, apiKeys)
-
-	maxWorkers, err := strconv.Atoi(workers)
+// printSearch prints the page of a private key. Verbose output has the detected format of the key
+// and where it is listed, followed by the rows of the context keys before and after it on its page,
+// exactly as the page commands print them.
+func printSearch(w io.Writer, coin keys.Coin, privateKey string, keysPerPage int, verbose bool, context int) error {
+	keyspace, err := coin.Keyspace(keysPerPage)
 	if err != nil {
-		panic(err)
-	}
-
-	printer := Printer{
-		mu:           &sync.Mutex{},
-		ch:           make(chan PrinterData, 10),
-		workersState: workerState{},
-		workers:      maxWorkers,
-		checker:      checker,
+		return err
 	}
 
-	f, err := os.OpenFile(outFile, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
+	key, err := coin.ParsePrivateKey(privateKey)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	//defer f.Close()
 
-	var mu sync.Mutex
-	writer := func(data string) {
-		mu.Lock()
-		defer mu.Unlock()
-		if _, err = f.WriteString(data); err != nil {
-			panic(err)
-		}
+	location, err := keyspace.LocateKey(key.Seed)
+	if err != nil {
+		return err
 	}
 
-	go printer.work()
+	if !verbose {
+		fmt.Fprintf(w, "%v\n", location.Page)
 
-	for i := 0; i < maxWorkers; i++ {
-		go brute(i, start, checker, printer.ch, writer)
+		return nil
 	}
-}
 
-func btcWorker(id int, start string, checker Checker, status chan PrinterData, writer func(string)) {
-	max := makeBigInt("904625697166532776746648320380374280100293470930272690489102837043110636675")
-	var pages uint64 = 0
-
-	for true {
-		founds := 0
-		pages++
-		pageNumber := getRand(max).String()
-		bitcoinKeys := generateBitcoinKeys(pageNumber, 128)
-
-		var toCheck []string
-
-		for _, k := range bitcoinKeys {
-			toCheck = append(toCheck, k.compressed)
-		}
-
-		result, found, err := checker.CheckBTC(toCheck)
-
-		if err != nil {
-			status <- PrinterData{
-				number:     id,
-				found:      founds,
-				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 128),
-				error:      err,
-			}
-			continue
-		}
-
-		if found {
-			for compressed, data := range result {
-				if data.FinalBalance > 0 || data.NTx > 0 {
-					founds++
-
-					var private, uncompressed string
-					for _, bitcoinKey := range bitcoinKeys {
-						if bitcoinKey.compressed == compressed {
-							private = bitcoinKey.private
-							uncompressed = bitcoinKey.uncompressed
-						}
-					}
-
-					writer(fmt.Sprintf("%v balance (total %v) (%v tx) | %v | %v | %v\n",
-						data.FinalBalance, data.TotalReceived, data.NTx, compressed, uncompressed, private))
-				}
-			}
-		}
+	fmt.Fprintf(w, "format %s\npage %v\nindex %d\nseed %v", key.Format, location.Page, location.Index, location.Seed)
 
-		status <- PrinterData{
-			number:     id,
-			found:      founds,
-			pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 128),
-			error:      err,
-		}
+	if context <= 0 {
+		return nil
 	}
-}
 
-func ethWorker(id int, start string, checker Checker, status chan PrinterData, writer func(string)) {
-	max := makeBigInt("904625697166532776746648320380374280100293470930272690489102837043110636675")
-	var pages int64 = 0
-	var startPage *big.Int
-	if start != "" {
-		startPage = makeBigInt(findEthPrivateKeyPage(start, 20))
+	pageRows, err := coinRows(coin, keysPerPage)(location.Page.String())
+	if err != nil {
+		return err
 	}
 
-	for true {
-		founds := 0
-		pages++
-		var pageNumber string
-
-		if startPage != nil {
-			pageNumber = fmt.Sprintf("%d", new(big.Int).Add(startPage, big.NewInt(pages-1)))
-		} else {
-			pageNumber = getRand(max).String()
-		}
-
-		ethereumKeys := generateEthereumKeys(pageNumber, 20)
-
-		var toCheck []string
-
-		for _, k := range ethereumKeys {
-			toCheck = append(toCheck, k.public)
-		}
-
-		result, found, err := checker.CheckETH(toCheck)
-
-		if err != nil {
-			status <- PrinterData{
-				number:     id,
-				found:      founds,
-				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
-				error:      err,
-			}
-			continue
-		}
-
-		if found {
-			for _, data := range result {
-				if data.Balance != "0" {
-					founds++
-
-					var private string
-					for _, eKey := range ethereumKeys {
-						if eKey.public == data.Account {
-							private = eKey.private
-						}
-					}
-
-					writer(fmt.Sprintf("%v balance | %v | %v\n",
-						data.Balance, data.Account, private))
-				}
-			}
-		}
-
-		status <- PrinterData{
-			number:     id,
-			found:      founds,
-			pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
-			error:      err,
-		}
+	records, err := pageRecords(keyspace, location.Page.String(), pageRows)
+	if err != nil {
+		return err
 	}
-}
 
-func bscWorker(id int, start string, checker Checker, status chan PrinterData, writer func(string)) {
-	max := makeBigInt("904625697166532776746648320380374280100293470930272690489102837043110636675")
-	var pages int64 = 0
-	var startPage *big.Int
-	if start != "" {
-		startPage = makeBigInt(findEthPrivateKeyPage(start, 20))
+	from, to := location.Index-context, location.Index+context+1
+	if from < 0 {
+		from = 0
 	}
-
-	for true {
-		founds := 0
-		pages++
-		var pageNumber string
-
-		if startPage != nil {
-			pageNumber = fmt.Sprintf("%d", new(big.Int).Add(startPage, big.NewInt(pages-1)))
-		} else {
-			pageNumber = getRand(max).String()
-		}
-
-		ethereumKeys := generateEthereumKeys(pageNumber, 20)
-
-		var toCheck []string
-
-		for _, k := range ethereumKeys {
-			toCheck = append(toCheck, k.public)
-		}
-
-		result, found, err := checker.CheckBSC(toCheck)
-
-		if err != nil {
-			status <- PrinterData{
-				number:     id,
-				found:      founds,
-				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
-				error:      err,
-			}
-			continue
-		}
-
-		if found {
-			for _, data := range result {
-				if data.Balance != "0" {
-					founds++
-
-					var private string
-					for _, eKey := range ethereumKeys {
-						if eKey.public == data.Account {
-							private = eKey.private
-						}
-					}
-
-					writer(fmt.Sprintf("%v balance | %v | %v\n",
-						data.Balance, data.Account, private))
-				}
-			}
-		}
-
-		status <- PrinterData{
-			number:     id,
-			found:      founds,
-			pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
-			error:      err,
-		}
+	if to > len(records) {
+		to = len(records)
 	}
-}
 
-type PrinterData struct {
-	number     int
-	found      int
-	pageNumber string
-	error      error
-}
-
-type workerState struct {
-	pagesTotal uint64
-	found      int
-	errors     int
-	pageNumber string
-}
+	fmt.Fprint(w, "\n\n")
 
-type Printer struct {
-	mu           *sync.Mutex
-	checker      Checker
-	ch           chan PrinterData
-	workersState workerState
-	workers      int
-	lastError    error
+	return writeText(w, records[from:to])
 }
 
-func (p *Printer) work() {
-	go func() {
-		for data := range p.ch {
-			p.mu.Lock()
-			p.workersState.pagesTotal += 1
-			p.workersState.found += data.found
-			p.workersState.pageNumber = data.pageNumber
-			if data.error != nil {
-				p.workersState.errors += 1
-				p.lastError = data.error
-			}
-			p.mu.Unlock()
-		}
-	}()
-
-	ticker := time.NewTicker(time.Second * 1)
+func printPageNumber(w io.Writer, page *big.Int) error {
+	fmt.Fprintf(w, "%v", page)
 
-	//fmt.Print("\033[s")
+	return nil
+}
 
-	for _ = range ticker.C {
-		//fmt.Print("\033[u\033[K")
-		p.mu.Lock()
-		fmt.Printf("\r workers[%v] proxys[%v] pages=%v found=%v errors=%v pageNumber=%v",
-			p.workers,
-			p.checker.AvaibleProxy(),
-			p.workersState.pagesTotal,
-			p.workersState.found,
-			p.workersState.errors,
-			p.workersState.pageNumber)
-		if p.lastError != nil {
-			fmt.Printf(" (last error: %v)", p.lastError)
-		}
-		p.mu.Unlock()
+// exitCode maps an error to an exit code
+func exitCode(err error) int {
+	var usageErr usageError
+
+	switch {
+	case errors.As(err, &usageErr):
+		return exitUsage
+	case errors.Is(err, keys.ErrInvalidKeysPerPage):
+		return exitUsage
+	case errors.Is(err, keys.ErrInvalidPage):
+		return exitInvalidPage
+	case errors.Is(err, keys.ErrPageOutOfRange):
+		return exitPageOutOfRange
+	case errors.Is(err, keys.ErrMalformedKey):
+		return exitMalformedKey
+	case errors.Is(err, keys.ErrKeyOutOfRange):
+		return exitKeyOutOfRange
+	default:
+		return exitError
 	}
-
 }
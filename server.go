@@ -0,0 +1,382 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/leporel/keys-generator/keys"
+)
+
+// server serves the pages and search of every registered coin as a JSON API:
+//
+//	GET /api/btc/<page>?keys-per-page=128&network=mainnet
+//	GET /api/eth/<page>?keys-per-page=128
+//	GET /api/btc-search?key=<private key>&keys-per-page=128&network=mainnet
+//	GET /api/eth-search?key=<private key>&keys-per-page=128
+//
+// and as HTML under the same paths without /api. The network parameter is only read for coins
+// with networks.
+type server struct {
+	mux   *http.ServeMux
+	cache *pageCache
+}
+
+// pageResponse is a page of the JSON API
+type pageResponse struct {
+	Page        string   `json:"page"`
+	KeysPerPage int      `json:"keysPerPage"`
+	Network     string   `json:"network,omitempty"`
+	Links       links    `json:"links"`
+	Keys        []record `json:"keys"`
+}
+
+// searchResponse is the detected format of a key and where it is listed
+type searchResponse struct {
+	Format      string `json:"format"`
+	Page        string `json:"page"`
+	Index       int    `json:"index"`
+	Seed        string `json:"seed"`
+	KeysPerPage int    `json:"keysPerPage"`
+	Network     string `json:"network,omitempty"`
+	Links       links  `json:"links"`
+}
+
+// links are the URLs of the neighbouring pages, prev and next are left out on the first and last page.
+// The links of a search response only have the page that the key is on.
+type links struct {
+	Page  string `json:"page,omitempty"`
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// newServer returns a server that caches up to cacheSize rendered pages
+func newServer(cacheSize int) *server {
+	s := &server{
+		mux:   http.NewServeMux(),
+		cache: newPageCache(cacheSize),
+	}
+
+	for _, coin := range keys.Coins() {
+		prefix := "/api/" + coin.Name() + "/"
+
+		s.mux.HandleFunc(prefix, s.coinPage(coin, prefix, apiFormat{}))
+		s.mux.HandleFunc("/api/"+coin.Name()+"-search", apiSearch(coin, prefix))
+	}
+
+	s.handleBrowser()
+
+	return s
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"method not allowed"})
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// pageFormat renders the pages and errors of a route, as JSON for the API or as HTML for the browser
+type pageFormat interface {
+	contentType() string
+	renderPage(keyspace *keys.Keyspace, page pageResponse) ([]byte, error)
+	writeError(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type apiFormat struct{}
+
+func (apiFormat) contentType() string {
+	return "application/json"
+}
+
+func (apiFormat) renderPage(keyspace *keys.Keyspace, page pageResponse) ([]byte, error) {
+	return marshalJSON(page)
+}
+
+func (apiFormat) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, err)
+}
+
+// coinPage serves the pages of a coin, their paths start with prefix
+func (s *server) coinPage(coin keys.Coin, prefix string, format pageFormat) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		keysPerPage, err := keysPerPageParam(r)
+		if err != nil {
+			format.writeError(w, r, err)
+			return
+		}
+
+		network, coin, err := networkParam(r, coin)
+		if err != nil {
+			format.writeError(w, r, err)
+			return
+		}
+
+		keyspace, err := coin.Keyspace(keysPerPage)
+		if err != nil {
+			format.writeError(w, r, err)
+			return
+		}
+
+		s.servePage(w, r, prefix, format, keyspace, network, coinRows(coin, keysPerPage))
+	}
+}
+
+// servePage renders a page, or takes it from the cache. Pages are cached by their URL with the
+// parsed page number, so "007" and "7" are the same page. A request without a page number in
+// the path, like the jump-to-page form, is redirected to the page of its "page" parameter.
+func (s *server) servePage(w http.ResponseWriter, r *http.Request, prefix string, format pageFormat, keyspace *keys.Keyspace, network string,
+	rows func(pageNumber string) ([][]field, error)) {
+	pageNumber := strings.TrimPrefix(r.URL.Path, prefix)
+
+	jump := pageNumber == ""
+	if jump {
+		pageNumber = r.URL.Query().Get("page")
+		if pageNumber == "" {
+			pageNumber = keyspace.FirstPage().String()
+		}
+	}
+
+	page, err := keyspace.Page(strings.TrimSpace(pageNumber))
+	if err != nil {
+		format.writeError(w, r, err)
+		return
+	}
+
+	link := pageURL(prefix, page.String(), keyspace.KeysPerPage(), network)
+
+	if jump {
+		http.Redirect(w, r, link, http.StatusSeeOther)
+		return
+	}
+
+	if body, ok := s.cache.get(link); ok {
+		writeBody(w, format.contentType(), http.StatusOK, body)
+		return
+	}
+
+	pageRows, err := rows(page.String())
+	if err != nil {
+		format.writeError(w, r, err)
+		return
+	}
+
+	records, err := pageRecords(keyspace, page.String(), pageRows)
+	if err != nil {
+		format.writeError(w, r, err)
+		return
+	}
+
+	body, err := format.renderPage(keyspace, pageResponse{
+		Page:        page.String(),
+		KeysPerPage: keyspace.KeysPerPage(),
+		Network:     network,
+		Links:       pageLinks(prefix, keyspace, network, page),
+		Keys:        records,
+	})
+	if err != nil {
+		format.writeError(w, r, err)
+		return
+	}
+
+	s.cache.add(link, body)
+
+	writeBody(w, format.contentType(), http.StatusOK, body)
+}
+
+// search finds the page of the private key in the "key" parameter, its link starts with prefix
+func search(r *http.Request, coin keys.Coin, prefix string) (searchResponse, error) {
+	keysPerPage, err := keysPerPageParam(r)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	network, coin, err := networkParam(r, coin)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	keyspace, err := coin.Keyspace(keysPerPage)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	key, err := coin.ParsePrivateKey(r.URL.Query().Get("key"))
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	location, err := keyspace.LocateKey(key.Seed)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	return searchResponse{
+		Format:      string(key.Format),
+		Page:        location.Page.String(),
+		Index:       location.Index,
+		Seed:        location.Seed.String(),
+		KeysPerPage: keyspace.KeysPerPage(),
+		Network:     network,
+		Links:       links{Page: pageURL(prefix, location.Page.String(), keyspace.KeysPerPage(), network)},
+	}, nil
+}
+
+func apiSearch(coin keys.Coin, prefix string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response, err := search(r, coin, prefix)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, response)
+	}
+}
+
+// keysPerPageParam returns the keys-per-page query parameter, or the default page size
+func keysPerPageParam(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("keys-per-page")
+	if value == "" {
+		return defaultKeysPerPage, nil
+	}
+
+	keysPerPage, err := strconv.Atoi(value)
+	if err != nil || keysPerPage < 1 || keysPerPage > maxKeysPerPage {
+		return 0, fmt.Errorf("%w %q, expected 1 to %d", keys.ErrInvalidKeysPerPage, value, maxKeysPerPage)
+	}
+
+	return keysPerPage, nil
+}
+
+// networkParam returns the network query parameter and the coin on that network, the first
+// network of the coin by default. Coins without networks have an empty network.
+func networkParam(r *http.Request, coin keys.Coin) (string, keys.Coin, error) {
+	networks := coin.Networks()
+	if len(networks) == 0 {
+		return "", coin, nil
+	}
+
+	network := r.URL.Query().Get("network")
+	if network == "" {
+		network = networks[0]
+	}
+
+	coin, err := coin.Network(network)
+	if err != nil {
+		return "", nil, usageError{err}
+	}
+
+	return network, coin, nil
+}
+
+func pageLinks(prefix string, keyspace *keys.Keyspace, network string, page *big.Int) links {
+	l := links{
+		First: pageURL(prefix, keyspace.FirstPage().String(), keyspace.KeysPerPage(), network),
+		Last:  pageURL(prefix, keyspace.LastPage().String(), keyspace.KeysPerPage(), network),
+	}
+
+	if page.Cmp(keyspace.FirstPage()) > 0 {
+		l.Prev = pageURL(prefix, new(big.Int).Sub(page, big.NewInt(1)).String(), keyspace.KeysPerPage(), network)
+	}
+
+	if page.Cmp(keyspace.LastPage()) < 0 {
+		l.Next = pageURL(prefix, new(big.Int).Add(page, big.NewInt(1)).String(), keyspace.KeysPerPage(), network)
+	}
+
+	return l
+}
+
+// pageURL returns the URL of a page, default parameters are left out so that every page has one URL
+func pageURL(prefix, pageNumber string, keysPerPage int, network string) string {
+	query := pageParams(keysPerPage, network)
+
+	link := prefix + pageNumber
+	if len(query) > 0 {
+		link += "?" + query.Encode()
+	}
+
+	return link
+}
+
+// pageParams returns the query parameters of a page that are not the default
+func pageParams(keysPerPage int, network string) url.Values {
+	query := url.Values{}
+
+	if keysPerPage != defaultKeysPerPage {
+		query.Set("keys-per-page", strconv.Itoa(keysPerPage))
+	}
+
+	if network != "" && network != "mainnet" {
+		query.Set("network", network)
+	}
+
+	return query
+}
+
+// httpStatus maps an error to a status code, like exitCode maps it to an exit code
+func httpStatus(err error) int {
+	var usageErr usageError
+
+	switch {
+	case errors.As(err, &usageErr),
+		errors.Is(err, keys.ErrInvalidKeysPerPage),
+		errors.Is(err, keys.ErrInvalidPage),
+		errors.Is(err, keys.ErrMalformedKey):
+		return http.StatusBadRequest
+	case errors.Is(err, keys.ErrPageOutOfRange):
+		return http.StatusNotFound
+	case errors.Is(err, keys.ErrKeyOutOfRange):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, httpStatus(err), errorResponse{err.Error()})
+}
+
+// marshalJSON encodes a response with a trailing newline, without escaping the "&" of the links
+func marshalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	body, err := marshalJSON(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeBody(w, "application/json", status, body)
+}
+
+func writeBody(w http.ResponseWriter, contentType string, status int, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_server(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+		wantBody   []string
+	}{
+		{
+			"It serves a Bitcoin page",
+			http.MethodGet,
+			"/api/btc/1?keys-per-page=2",
+			http.StatusOK,
+			[]string{
+				`"page":"1","keysPerPage":2,"network":"mainnet"`,
+				`"links":{"first":"/api/btc/1?keys-per-page=2","next":"/api/btc/2?keys-per-page=2","last":"/api/btc/57896044618658097711785492504343953926418782139537452191302581570759080747168?keys-per-page=2"}`,
+				`{"page":"1","index":0,"seed":"1","status":"valid","private":"5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf"`,
+			},
+		},
+		{
+			"It serves a Bitcoin page of a network",
+			http.MethodGet,
+			"/api/btc/2?keys-per-page=1&network=testnet",
+			http.StatusOK,
+			[]string{
+				`"network":"testnet"`,
+				`"prev":"/api/btc/1?keys-per-page=1&network=testnet"`,
+				`"compressed":"mg8Jz5776UdyiYcBb9Z873NTozEiADRW5H"`,
+			},
+		},
+		{
+			"It serves the last Ethereum page without a next link",
+			http.MethodGet,
+			"/api/eth/904625697166532776746648320380374280100293470930272690489102837043110636675",
+			http.StatusOK,
+			[]string{
+				`"prev":"/api/eth/904625697166532776746648320380374280100293470930272690489102837043110636674"`,
+				`"last":"/api/eth/904625697166532776746648320380374280100293470930272690489102837043110636675"}`,
+			},
+		},
+		{
+			"It serves a combined page",
+			http.MethodGet,
+			"/api/all/1?keys-per-page=1&network=regtest",
+			http.StatusOK,
+			[]string{
+				`"network":"regtest"`,
+				`"btcNativeSegwit":"bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080"`,
+				`"ethPublic":"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"`,
+			},
+		},
+		{
+			"It finds a Bitcoin key",
+			http.MethodGet,
+			"/api/btc-search?key=5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreRBLscCA",
+			http.StatusOK,
+			[]string{`{"format":"WIF","page":"2","index":0,"seed":"129","keysPerPage":128,"network":"mainnet","links":{"page":"/api/btc/2"}}`},
+		},
+		{
+			"It finds an Ethereum key",
+			http.MethodGet,
+			"/api/eth-search?keys-per-page=1&key=0000000000000000000000000000000000000000000000000000000000000010",
+			http.StatusOK,
+			[]string{`{"format":"hex","page":"17","index":0,"seed":"16","keysPerPage":1,"links":{"page":"/api/eth/17?keys-per-page=1"}}`},
+		},
+		{
+			"It rejects an invalid page",
+			http.MethodGet,
+			"/api/btc/abc",
+			http.StatusBadRequest,
+			[]string{`"error":"invalid page number \"abc\""`},
+		},
+		{
+			"It rejects a page out of range",
+			http.MethodGet,
+			"/api/eth/904625697166532776746648320380374280100293470930272690489102837043110636676",
+			http.StatusNotFound,
+			[]string{"page out of range"},
+		},
+		{
+			"It rejects too many keys per page",
+			http.MethodGet,
+			"/api/eth/1?keys-per-page=100000",
+			http.StatusBadRequest,
+			[]string{"invalid number of keys per page"},
+		},
+		{
+			"It rejects an unknown network",
+			http.MethodGet,
+			"/api/btc/1?network=foonet",
+			http.StatusBadRequest,
+			[]string{`unknown bitcoin network \"foonet\"`},
+		},
+		{
+			"It finds a mini private key",
+			http.MethodGet,
+			"/api/btc-search?key=S6c56bnXQiBjk9mqSYE7ykVQ7NzrRy",
+			http.StatusOK,
+			[]string{`{"format":"mini private key","page":"270252881582779558292813824447935528849371135879642420246807724351999250324","index":42`},
+		},
+		{
+			"It rejects a malformed key",
+			http.MethodGet,
+			"/api/btc-search?key=not-a-wif",
+			http.StatusBadRequest,
+			[]string{"malformed private key"},
+		},
+		{
+			"It rejects a key outside the curve order",
+			http.MethodGet,
+			"/api/eth-search?key=ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			http.StatusUnprocessableEntity,
+			[]string{"outside the curve order"},
+		},
+		{
+			"It rejects other methods",
+			http.MethodPost,
+			"/api/btc/1",
+			http.StatusMethodNotAllowed,
+			[]string{"method not allowed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			newServer(10).ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.target, nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, recorder.Code)
+			}
+
+			if contentType := recorder.Header().Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("Expected a JSON response, got %q", contentType)
+			}
+
+			if !json.Valid(recorder.Body.Bytes()) {
+				t.Errorf("Expected valid JSON, got %s", recorder.Body)
+			}
+
+			for _, want := range tt.wantBody {
+				if !strings.Contains(recorder.Body.String(), want) {
+					t.Errorf("Expected body to contain: %v", want)
+					t.Errorf("Actual:                   %v", recorder.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func Test_server_cache(t *testing.T) {
+	s := newServer(2)
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	get := func(target string) string {
+		resp, err := http.Get(ts.URL + target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var page pageResponse
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			t.Fatal(err)
+		}
+
+		return page.Page
+	}
+
+	for _, target := range []string{"/api/btc/7", "/api/btc/007", "/api/btc/7?keys-per-page=128&network=mainnet"} {
+		if got := get(target); got != "7" {
+			t.Errorf("Expected page 7 for %s, got %s", target, got)
+		}
+	}
+
+	if s.cache.len() != 1 {
+		t.Errorf("Expected the same page to be cached once, got %d entries", s.cache.len())
+	}
+
+	get("/api/eth/1")
+	get("/api/eth/2")
+
+	if s.cache.len() != 2 {
+		t.Errorf("Expected the cache to hold 2 pages, got %d", s.cache.len())
+	}
+
+	if _, ok := s.cache.get("/api/btc/7"); ok {
+		t.Errorf("Expected the least recently used page to be evicted")
+	}
+}
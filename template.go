@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"path/filepath"
+	"text/template"
+
+	"github.com/leporel/keys-generator/keys"
+)
+
+// Names of the templates that a -template file can define to render a page row by row
+const (
+	templateHeader = "header"
+	templateRow    = "row"
+	templateFooter = "footer"
+)
+
+// pageView is the data that a page template, its header and its footer are executed with
+type pageView struct {
+	Page         string
+	FirstPage    string
+	LastPage     string
+	PreviousPage string // empty on the first page
+	NextPage     string // empty on the last page
+	KeysPerPage  int
+	Columns      []string // names of the Fields of every key, in output order
+	Keys         []keyView
+}
+
+// keyView is the data that a row template is executed with, one per key of the page
+type keyView struct {
+	Page   string
+	Index  int
+	Seed   string
+	Status string            // valid, wrapped or invalid, see keys.SeedScalar
+	Fields map[string]string // the fields of the chain by name, e.g. {{.Fields.taproot}}
+}
+
+// loadTemplate parses a template file. Unknown field names are an error instead of "<no value>".
+func loadTemplate(path string) (*template.Template, error) {
+	tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").ParseFiles(path)
+	if err != nil {
+		return nil, usageError{fmt.Errorf("template: %w", err)}
+	}
+
+	return tmpl, nil
+}
+
+func newPageView(keyspace *keys.Keyspace, records []record) pageView {
+	view := pageView{
+		FirstPage:   keyspace.FirstPage().String(),
+		LastPage:    keyspace.LastPage().String(),
+		KeysPerPage: keyspace.KeysPerPage(),
+		Keys:        make([]keyView, 0, len(records)),
+	}
+
+	for _, r := range records {
+		fields := make(map[string]string, len(r.fields))
+		for _, f := range r.fields {
+			fields[f.name] = f.value
+		}
+
+		view.Keys = append(view.Keys, keyView{Page: r.page, Index: r.index, Seed: r.seed, Status: string(r.status), Fields: fields})
+	}
+
+	if len(records) > 0 {
+		for _, f := range records[0].fields {
+			view.Columns = append(view.Columns, f.name)
+		}
+
+		page, _ := keyspace.Page(records[0].page)
+		view.Page = page.String()
+
+		if page.Cmp(keyspace.FirstPage()) > 0 {
+			view.PreviousPage = new(big.Int).Sub(page, big.NewInt(1)).String()
+		}
+
+		if page.Cmp(keyspace.LastPage()) < 0 {
+			view.NextPage = new(big.Int).Add(page, big.NewInt(1)).String()
+		}
+	}
+
+	return view
+}
+
+// writeTemplate executes a template for a page. A template that defines "row" is executed once
+// per key, between its optional "header" and "footer"; any other template is executed once
+// with the whole page.
+func writeTemplate(w io.Writer, tmpl *template.Template, view pageView) error {
+	if tmpl.Lookup(templateRow) == nil {
+		return tmpl.Execute(w, view)
+	}
+
+	if tmpl.Lookup(templateHeader) != nil {
+		if err := tmpl.ExecuteTemplate(w, templateHeader, view); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range view.Keys {
+		if err := tmpl.ExecuteTemplate(w, templateRow, key); err != nil {
+			return err
+		}
+	}
+
+	if tmpl.Lookup(templateFooter) != nil {
+		return tmpl.ExecuteTemplate(w, templateFooter, view)
+	}
+
+	return nil
+}
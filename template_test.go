@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/leporel/keys-generator/keys"
+)
+
+func Test_writeTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		golden   string
+		print    func(w *bytes.Buffer, out output) error
+	}{
+		{"It renders rows between a header and a footer", "btc-markdown.tmpl", "btc.md", func(w *bytes.Buffer, out output) error {
+			return printKeys(w, keys.Bitcoin, "2", 2, out)
+		}},
+		{"It renders a whole page", "eth-page.tmpl", "eth.tex", func(w *bytes.Buffer, out output) error {
+			return printKeys(w, keys.Ethereum, "1", 3, out)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := loadTemplate(filepath.Join("testdata", tt.template))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got bytes.Buffer
+
+			if err := tt.print(&got, output{template: tmpl}); err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", tt.golden)
+
+			if *update {
+				if err := ioutil.WriteFile(golden, got.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("Expected:\n%s", want)
+				t.Errorf("Actual:\n%s", got.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/leporel/keys-generator/keys"
+)
+
+// bruteFunc checks pages for balances, from startPage on or random pages when startPage is nil
+type bruteFunc func(id int, startPage *big.Int, checker Checker, status chan PrinterData, writer func(string))
+
+func bruteKeys(maxWorkers int, limit int, apiKeys []string, startPage *big.Int, outFile string, brute bruteFunc) {
+	checker := NewChecker(limit, apiKeys)
+
+	printer := Printer{
+		mu:           &sync.Mutex{},
+		ch:           make(chan PrinterData, 10),
+		workersState: workerState{},
+		workers:      maxWorkers,
+		checker:      checker,
+	}
+
+	f, err := os.OpenFile(outFile, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		panic(err)
+	}
+	//defer f.Close()
+
+	var mu sync.Mutex
+	writer := func(data string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, err = f.WriteString(data); err != nil {
+			panic(err)
+		}
+	}
+
+	go printer.work()
+
+	for i := 0; i < maxWorkers; i++ {
+		go brute(i, startPage, checker, printer.ch, writer)
+	}
+}
+
+func btcWorker(id int, startPage *big.Int, checker Checker, status chan PrinterData, writer func(string)) {
+	keyspace, err := keys.BitcoinKeyspace(128)
+	if err != nil {
+		panic(err)
+	}
+	var pages uint64 = 0
+
+	for true {
+		founds := 0
+		pages++
+		page, err := keyspace.RandomPage(rand.Reader)
+		if err != nil {
+			status <- PrinterData{number: id, found: founds, error: err}
+			continue
+		}
+		pageNumber := page.String()
+		bitcoinKeys, err := keys.GenerateBitcoinKeys(pageNumber, 128, &chaincfg.MainNetParams)
+		if err != nil {
+			status <- PrinterData{
+				number:     id,
+				found:      founds,
+				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 128),
+				error:      err,
+			}
+			continue
+		}
+
+		var toCheck []string
+
+		for _, k := range bitcoinKeys {
+			// Invalid seeds have no address
+			if k.Compressed == "" {
+				continue
+			}
+			toCheck = append(toCheck, k.Compressed)
+		}
+
+		result, found, err := checker.CheckBTC(toCheck)
+
+		if err != nil {
+			status <- PrinterData{
+				number:     id,
+				found:      founds,
+				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 128),
+				error:      err,
+			}
+			continue
+		}
+
+		if found {
+			for compressed, data := range result {
+				if data.FinalBalance > 0 || data.NTx > 0 {
+					founds++
+
+					var private, privateCompressed, uncompressed string
+					for _, bitcoinKey := range bitcoinKeys {
+						if bitcoinKey.Compressed == compressed {
+							private = bitcoinKey.Private
+							privateCompressed = bitcoinKey.PrivateCompressed
+							uncompressed = bitcoinKey.Uncompressed
+						}
+					}
+
+					writer(fmt.Sprintf("%v balance (total %v) (%v tx) | %v | %v | %v | %v\n",
+						data.FinalBalance, data.TotalReceived, data.NTx, compressed, uncompressed, private, privateCompressed))
+				}
+			}
+		}
+
+		status <- PrinterData{
+			number:     id,
+			found:      founds,
+			pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 128),
+			error:      err,
+		}
+	}
+}
+
+// sequentialPage returns the page that a worker started at startPage checks after the given number
+// of pages, it wraps around to the first page after the last page of the keyspace
+func sequentialPage(keyspace *keys.Keyspace, startPage *big.Int, pages int64) *big.Int {
+	page := new(big.Int).Sub(startPage, keyspace.FirstPage())
+	page.Add(page, big.NewInt(pages))
+	page.Mod(page, keyspace.LastPage())
+
+	return page.Add(page, keyspace.FirstPage())
+}
+
+func ethWorker(id int, startPage *big.Int, checker Checker, status chan PrinterData, writer func(string)) {
+	keyspace, err := keys.EthereumKeyspace(20)
+	if err != nil {
+		panic(err)
+	}
+	var pages int64 = 0
+
+	for true {
+		founds := 0
+		pages++
+		var pageNumber string
+
+		if startPage != nil {
+			pageNumber = sequentialPage(keyspace, startPage, pages-1).String()
+		} else {
+			page, err := keyspace.RandomPage(rand.Reader)
+			if err != nil {
+				status <- PrinterData{number: id, found: founds, error: err}
+				continue
+			}
+			pageNumber = page.String()
+		}
+
+		ethereumKeys, err := keys.GenerateEthereumKeys(pageNumber, 20)
+		if err != nil {
+			status <- PrinterData{
+				number:     id,
+				found:      founds,
+				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
+				error:      err,
+			}
+			continue
+		}
+
+		var toCheck []string
+
+		for _, k := range ethereumKeys {
+			// Invalid seeds have no address
+			if k.Public == "" {
+				continue
+			}
+			toCheck = append(toCheck, k.Public)
+		}
+
+		result, found, err := checker.CheckETH(toCheck)
+
+		if err != nil {
+			status <- PrinterData{
+				number:     id,
+				found:      founds,
+				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
+				error:      err,
+			}
+			continue
+		}
+
+		if found {
+			for _, data := range result {
+				if data.Balance != "0" {
+					founds++
+
+					var private string
+					for _, eKey := range ethereumKeys {
+						if eKey.Public == data.Account {
+							private = eKey.Private
+						}
+					}
+
+					writer(fmt.Sprintf("%v balance | %v | %v\n",
+						data.Balance, data.Account, private))
+				}
+			}
+		}
+
+		status <- PrinterData{
+			number:     id,
+			found:      founds,
+			pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
+			error:      err,
+		}
+	}
+}
+
+func bscWorker(id int, startPage *big.Int, checker Checker, status chan PrinterData, writer func(string)) {
+	keyspace, err := keys.EthereumKeyspace(20)
+	if err != nil {
+		panic(err)
+	}
+	var pages int64 = 0
+
+	for true {
+		founds := 0
+		pages++
+		var pageNumber string
+
+		if startPage != nil {
+			pageNumber = sequentialPage(keyspace, startPage, pages-1).String()
+		} else {
+			page, err := keyspace.RandomPage(rand.Reader)
+			if err != nil {
+				status <- PrinterData{number: id, found: founds, error: err}
+				continue
+			}
+			pageNumber = page.String()
+		}
+
+		ethereumKeys, err := keys.GenerateEthereumKeys(pageNumber, 20)
+		if err != nil {
+			status <- PrinterData{
+				number:     id,
+				found:      founds,
+				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
+				error:      err,
+			}
+			continue
+		}
+
+		var toCheck []string
+
+		for _, k := range ethereumKeys {
+			// Invalid seeds have no address
+			if k.Public == "" {
+				continue
+			}
+			toCheck = append(toCheck, k.Public)
+		}
+
+		result, found, err := checker.CheckBSC(toCheck)
+
+		if err != nil {
+			status <- PrinterData{
+				number:     id,
+				found:      founds,
+				pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
+				error:      err,
+			}
+			continue
+		}
+
+		if found {
+			for _, data := range result {
+				if data.Balance != "0" {
+					founds++
+
+					var private string
+					for _, eKey := range ethereumKeys {
+						if eKey.Public == data.Account {
+							private = eKey.Private
+						}
+					}
+
+					writer(fmt.Sprintf("%v balance | %v | %v\n",
+						data.Balance, data.Account, private))
+				}
+			}
+		}
+
+		status <- PrinterData{
+			number:     id,
+			found:      founds,
+			pageNumber: fmt.Sprintf("%s(%d adresses)", pageNumber, 20),
+			error:      err,
+		}
+	}
+}
+
+type PrinterData struct {
+	number     int
+	found      int
+	pageNumber string
+	error      error
+}
+
+type workerState struct {
+	pagesTotal uint64
+	found      int
+	errors     int
+	pageNumber string
+}
+
+type Printer struct {
+	mu           *sync.Mutex
+	checker      Checker
+	ch           chan PrinterData
+	workersState workerState
+	workers      int
+	lastError    error
+}
+
+func (p *Printer) work() {
+	go func() {
+		for data := range p.ch {
+			p.mu.Lock()
+			p.workersState.pagesTotal += 1
+			p.workersState.found += data.found
+			p.workersState.pageNumber = data.pageNumber
+			if data.error != nil {
+				p.workersState.errors += 1
+				p.lastError = data.error
+			}
+			p.mu.Unlock()
+		}
+	}()
+
+	ticker := time.NewTicker(time.Second * 1)
+
+	//fmt.Print("\033[s")
+
+	for _ = range ticker.C {
+		//fmt.Print("\033[u\033[K")
+		p.mu.Lock()
+		fmt.Printf("\r workers[%v] proxys[%v] pages=%v found=%v errors=%v pageNumber=%v",
+			p.workers,
+			p.checker.AvaibleProxy(),
+			p.workersState.pagesTotal,
+			p.workersState.found,
+			p.workersState.errors,
+			p.workersState.pageNumber)
+		if p.lastError != nil {
+			fmt.Printf(" (last error: %v)", p.lastError)
+		}
+		p.mu.Unlock()
+	}
+
+}